@@ -0,0 +1,161 @@
+package wordcase
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// GitHubAnchor converts a heading into the anchor GitHub generates for it: lowercase, with punctuation and symbols
+// removed and each space replaced by a hyphen, eg "Foo & Bar!" -> "foo--bar", "😄 emoji" -> "-emoji"
+var GitHubAnchor = NewPipeline().
+	WithAllFormatter(strings.TrimSpace).
+	WithAllFormatter(strings.ToLower).
+	WithAllFormatter(dropRunes(isNotWordRune("-_ "))).
+	WithAllFormatter(strings.NewReplacer(" ", "-").Replace).
+	JoinWith("")
+
+// GitLabAnchor converts a heading into the anchor GitLab generates for it: lowercase, with punctuation and symbols
+// removed, and runs of spaces and hyphens replaced by a single hyphen, eg "Foo & Bar!" -> "foo-bar"
+var GitLabAnchor = NewPipeline().
+	WithAllFormatter(strings.TrimSpace).
+	WithAllFormatter(strings.ToLower).
+	WithAllFormatter(dropRunes(isNotWordRune("-_ "))).
+	WithAllFormatter(replaceRegexp(regexp.MustCompile(`[ -]+`), "-")).
+	JoinWith("")
+
+// pandocIdentifier makes the identifier for PandocAnchor, which may be empty
+var pandocIdentifier = NewPipeline().
+	WithAllFormatter(strings.ToLower).
+	WithAllFormatter(dropRunes(isNotPandocRune)).
+	WithAllFormatter(trimToLetter).
+	SplitRegexp(regexp.MustCompile(`\s+`), true).
+	JoinWith("-")
+
+// pandocDefaultAnchor is the anchor Pandoc uses when a heading leaves nothing else
+const pandocDefaultAnchor = "section"
+
+// PandocAnchor converts a heading into the identifier Pandoc generates for it with the auto_identifiers extension:
+// lowercase letters, digits, underscores, hyphens and periods, with whitespace replaced by a hyphen and anything
+// before the first letter removed, or "section" if that leaves nothing, eg "Foo & Bar!" -> "foo-bar",
+// "3. Applications" -> "applications"
+func PandocAnchor(s string) string {
+	if id := pandocIdentifier(s); id != "" {
+		return id
+	}
+	return pandocDefaultAnchor
+}
+
+// AnchorSet generates unique anchors for the headings of a document, adding a suffix the way the renderer does when
+// a heading's anchor has already been used
+type AnchorSet struct {
+	style  Combiner
+	unique func(seen map[string]int, id string) string
+	seen   map[string]int
+}
+
+// NewAnchorSet creates an AnchorSet for the style, making anchors unique by appending "-1", "-2", etc
+func NewAnchorSet(style Combiner) *AnchorSet {
+	return &AnchorSet{style: style, unique: firstUnusedSuffix, seen: map[string]int{}}
+}
+
+// NewGitHubAnchors creates an AnchorSet that generates the same anchors as GitHub
+func NewGitHubAnchors() *AnchorSet {
+	return &AnchorSet{style: GitHubAnchor, unique: countedUnusedSuffix, seen: map[string]int{}}
+}
+
+// NewGitLabAnchors creates an AnchorSet that generates the same anchors as GitLab.
+//
+//	GitLab only counts the repeats of each anchor, so a heading can still get the anchor already generated for another,
+//	eg "Foo", "Foo 1", "Foo" -> "foo", "foo-1", "foo-1"
+func NewGitLabAnchors() *AnchorSet {
+	return &AnchorSet{style: GitLabAnchor, unique: countedSuffix, seen: map[string]int{}}
+}
+
+// NewPandocAnchors creates an AnchorSet that generates the same identifiers as Pandoc
+func NewPandocAnchors() *AnchorSet {
+	return NewAnchorSet(PandocAnchor)
+}
+
+// Anchor returns the anchor for the next heading in the document
+func (a *AnchorSet) Anchor(heading string) string {
+	return a.unique(a.seen, a.style(heading))
+}
+
+// Reset forgets the anchors generated so far, to start a new document
+func (a *AnchorSet) Reset() {
+	a.seen = map[string]int{}
+}
+
+// firstUnusedSuffix returns the id, or the id with the first number from 1 up appended that hasn't been used
+func firstUnusedSuffix(seen map[string]int, id string) string {
+	candidate := id
+	for n := 1; seen[candidate] > 0; n++ {
+		candidate = id + "-" + strconv.Itoa(n)
+	}
+	seen[candidate]++
+	return candidate
+}
+
+// countedUnusedSuffix returns the id, or the id with the number of times it's been seen appended, counting on
+// until that hasn't been used (as github-slugger does)
+func countedUnusedSuffix(seen map[string]int, id string) string {
+	candidate := id
+	for {
+		if _, ok := seen[candidate]; !ok {
+			break
+		}
+		seen[id]++
+		candidate = id + "-" + strconv.Itoa(seen[id])
+	}
+	seen[candidate] = 0
+	return candidate
+}
+
+// countedSuffix returns the id, with the number of times it's been seen before appended if that's not zero
+func countedSuffix(seen map[string]int, id string) string {
+	n := seen[id]
+	seen[id]++
+	if n == 0 {
+		return id
+	}
+	return id + "-" + strconv.Itoa(n)
+}
+
+// isNotWordRune returns a function that's true for runes that aren't letters, marks, digits, connector punctuation
+// (eg "_"), or one of the extra runes given
+func isNotWordRune(extra string) func(rune) bool {
+	return func(r rune) bool {
+		return !unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.Pc) && !strings.ContainsRune(extra, r)
+	}
+}
+
+// isNotPandocRune returns true for runes Pandoc removes from identifiers
+func isNotPandocRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r) && !unicode.IsSpace(r) && !strings.ContainsRune("_-.", r)
+}
+
+// trimToLetter removes everything before the first letter
+func trimToLetter(s string) string {
+	return strings.TrimLeftFunc(s, func(r rune) bool { return !unicode.IsLetter(r) })
+}
+
+// dropRunes returns a Formatter that removes the runes the function matches
+func dropRunes(drop func(rune) bool) Formatter {
+	return func(s string) string {
+		return strings.Map(func(r rune) rune {
+			if drop(r) {
+				return -1
+			}
+			return r
+		}, s)
+	}
+}
+
+// replaceRegexp returns a Formatter that replaces the text matched by the expression
+func replaceRegexp(re *regexp.Regexp, repl string) Formatter {
+	return func(s string) string {
+		return re.ReplaceAllLiteralString(s, repl)
+	}
+}
@@ -0,0 +1,163 @@
+package wordcase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGitHubAnchor provides unit test coverage for GitHubAnchor(), with cases from the github-slugger test fixtures
+func TestGitHubAnchor(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "empty", s: "", want: ""},
+		{name: "words", s: "Hello World", want: "hello-world"},
+		{name: "punctuation", s: "Foo & Bar!", want: "foo--bar"},
+		{name: "each space", s: "foo  bar", want: "foo--bar"},
+		{name: "hyphens and underscores kept", s: "snake_case and kebab-case", want: "snake_case-and-kebab-case"},
+		{name: "periods removed", s: "v1.2.3 Release", want: "v123-release"},
+		{name: "non-latin", s: "Привет non-latin 你好", want: "привет-non-latin-你好"},
+		{name: "emoji", s: "😄 emoji", want: "-emoji"},
+		{name: "diacritics kept", s: "Crème Brûlée", want: "crème-brûlée"},
+		{name: "code", s: "`JoinWith()` method", want: "joinwith-method"},
+		{name: "trimmed", s: "  Heading  ", want: "heading"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, GitHubAnchor(tt.s))
+		})
+	}
+}
+
+// TestGitLabAnchor provides unit test coverage for GitLabAnchor(), with cases from the GitLab Flavored Markdown docs
+func TestGitLabAnchor(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "empty", s: "", want: ""},
+		{name: "spaces", s: "This heading has spaces in it", want: "this-heading-has-spaces-in-it"},
+		{name: "unicode", s: "This heading has Unicode in it: 한글", want: "this-heading-has-unicode-in-it-한글"},
+		{name: "numbers and parentheses", s: "This heading has 3.5 in it (and parentheses)", want: "this-heading-has-35-in-it-and-parentheses"},
+		{name: "multiple spaces", s: "This heading has  multiple   spaces", want: "this-heading-has-multiple-spaces"},
+		{name: "punctuation", s: "Foo & Bar!", want: "foo-bar"},
+		{name: "hyphens squeezed", s: "a - b -- c", want: "a-b-c"},
+		{name: "underscores kept", s: "snake_case", want: "snake_case"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, GitLabAnchor(tt.s))
+		})
+	}
+}
+
+// TestPandocAnchor provides unit test coverage for PandocAnchor(), with cases from the Pandoc manual
+func TestPandocAnchor(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "words", s: "Heading identifiers in HTML", want: "heading-identifiers-in-html"},
+		{name: "diacritics", s: "Maître d'hôtel", want: "maître-dhôtel"},
+		{name: "emphasis", s: "*Dogs*?--in *my* house?", want: "dogs--in-my-house"},
+		{name: "links", s: "[HTML], [S5], or [RTF]?", want: "html-s5-or-rtf"},
+		{name: "leading number", s: "3. Applications", want: "applications"},
+		{name: "number only", s: "33", want: "section"},
+		{name: "empty", s: "", want: "section"},
+		{name: "punctuation", s: "Foo & Bar!", want: "foo-bar"},
+		{name: "periods kept", s: "Version 1.2", want: "version-1.2"},
+		{name: "whitespace runs", s: "a \t b", want: "a-b"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, PandocAnchor(tt.s))
+		})
+	}
+}
+
+// TestAnchorSet_Anchor provides unit test coverage for AnchorSet.Anchor()
+func TestAnchorSet_Anchor(t *testing.T) {
+	tests := []struct {
+		name     string
+		anchors  func() *AnchorSet
+		headings []string
+		want     []string
+	}{
+		{
+			name:     "github",
+			anchors:  NewGitHubAnchors,
+			headings: []string{"Foo", "Foo", "Foo 1", "Foo", "Bar"},
+			want:     []string{"foo", "foo-1", "foo-1-1", "foo-2", "bar"},
+		},
+		{
+			name:     "github counts on",
+			anchors:  NewGitHubAnchors,
+			headings: []string{"Foo", "Foo 1", "Foo"},
+			want:     []string{"foo", "foo-1", "foo-2"},
+		},
+		{
+			name:    "gitlab",
+			anchors: NewGitLabAnchors,
+			headings: []string{
+				"This heading has spaces in it", "This heading has spaces in it", "This heading has spaces in it",
+			},
+			want: []string{
+				"this-heading-has-spaces-in-it", "this-heading-has-spaces-in-it-1", "this-heading-has-spaces-in-it-2",
+			},
+		},
+		{
+			name:     "gitlab only counts",
+			anchors:  NewGitLabAnchors,
+			headings: []string{"Foo", "Foo 1", "Foo"},
+			want:     []string{"foo", "foo-1", "foo-1"},
+		},
+		{
+			name:     "pandoc",
+			anchors:  NewPandocAnchors,
+			headings: []string{"Foo", "Foo", "Foo 1", "Foo", "1", "2"},
+			want:     []string{"foo", "foo-1", "foo-1-1", "foo-2", "section", "section-1"},
+		},
+		{
+			name:     "style",
+			anchors:  func() *AnchorSet { return NewAnchorSet(SnakeCase) },
+			headings: []string{"Foo Bar", "fooBar", "foo_bar_1", "FOO_BAR"},
+			want:     []string{"foo_bar", "foo_bar-1", "foo_bar_1", "foo_bar-2"},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			a := tt.anchors()
+			got := make([]string, 0, len(tt.headings))
+			for _, h := range tt.headings {
+				got = append(got, a.Anchor(h))
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestAnchorSet_Reset provides unit test coverage for AnchorSet.Reset()
+func TestAnchorSet_Reset(t *testing.T) {
+	a := NewGitHubAnchors()
+	assert.Equal(t, "foo", a.Anchor("Foo"))
+	assert.Equal(t, "foo-1", a.Anchor("Foo"))
+	a.Reset()
+	assert.Equal(t, "foo", a.Anchor("Foo"))
+}
@@ -0,0 +1,178 @@
+package wordcase
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CollisionStrategy decides what happens when names in a batch convert to the same output
+type CollisionStrategy int
+
+// The ways of resolving collisions in a batch
+const (
+	ErrorOnCollision CollisionStrategy = iota // leave the outputs as converted and return a *CollisionError
+	NumericSuffix                             // number the outputs after the first, eg "user_id_2", "userID3"
+	KeepFirst                                 // keep the first output, and give the others an empty output
+)
+
+// String returns the name of the strategy
+func (s CollisionStrategy) String() string {
+	switch s {
+	case ErrorOnCollision:
+		return "error on collision"
+	case NumericSuffix:
+		return "numeric suffix"
+	case KeepFirst:
+		return "keep first"
+	default:
+		return "unknown strategy"
+	}
+}
+
+// Collision is a group of names in a batch that convert to the same output
+type Collision struct {
+	Output  string   // the output the names convert to
+	Names   []string // the names, in the order they appear in the batch
+	Indexes []int    // the positions of the names in the batch
+}
+
+// CollisionError reports the collisions in a batch
+type CollisionError struct {
+	Collisions []Collision
+}
+
+// Error implements error
+func (e *CollisionError) Error() string {
+	groups := make([]string, len(e.Collisions))
+	for i, c := range e.Collisions {
+		groups[i] = fmt.Sprintf("%q from %s", c.Output, quoteAll(c.Names))
+	}
+	return "names collide: " + strings.Join(groups, "; ")
+}
+
+// BatchRules describe how a batch of names is converted
+type BatchRules struct {
+	Style       Combiner          // converts each name
+	OnCollision CollisionStrategy // what to do when names convert to the same output
+	Separator   string            // placed before the number with NumericSuffix; if empty, the style's (see Convert)
+	IgnoreCase  bool              // outputs differing only in case collide, eg for case-insensitive SQL or file systems
+}
+
+// DetectCollisions returns the groups of names that convert to the same output with the style,
+// eg "userID" and "user_id" with SnakeCase. Names that convert to "" (eg "!!") aren't in any group
+func DetectCollisions(style Combiner, names []string) []Collision {
+	_, collisions := BatchRules{Style: style}.collide(names)
+	return collisions
+}
+
+// Convert converts each of the names with the style, returning the outputs (in the same order as the names) and
+// every group of names that collided, resolving the collisions following the strategy.
+//
+//	With NumericSuffix and no Separator, the numbered outputs are made by converting the name and number with the
+//	style, so they stay in it, eg "user_id_2" with SnakeCase and "userID2" with CamelCase.
+//	An output is empty where the name converts to "" (eg "!!"), which isn't counted as a collision, and for each
+//	name after the first in a collision with KeepFirst, so check for empty outputs before using them
+func (r BatchRules) Convert(names []string) ([]string, []Collision, error) {
+	out, collisions := r.collide(names)
+	if len(collisions) == 0 {
+		return out, nil, nil
+	}
+
+	switch r.OnCollision {
+	case NumericSuffix:
+		r.addSuffixes(names, out, collisions)
+	case KeepFirst:
+		for _, c := range collisions {
+			for _, i := range c.Indexes[1:] {
+				out[i] = ""
+			}
+		}
+	default:
+		return out, collisions, &CollisionError{Collisions: collisions}
+	}
+	return out, collisions, nil
+}
+
+// collide converts the names, returning the outputs and the groups of names with the same output
+func (r BatchRules) collide(names []string) ([]string, []Collision) {
+	out := make([]string, len(names))
+	groups := map[string][]int{}
+	var order []string
+	for i, n := range names {
+		out[i] = r.Style(n)
+		if out[i] == "" {
+			continue
+		}
+		key := r.key(out[i])
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	var collisions []Collision
+	for _, key := range order {
+		idx := groups[key]
+		if len(idx) < 2 {
+			continue
+		}
+		c := Collision{Output: out[idx[0]], Indexes: idx}
+		for _, i := range idx {
+			c.Names = append(c.Names, names[i])
+		}
+		collisions = append(collisions, c)
+	}
+	return out, collisions
+}
+
+// addSuffixes numbers the outputs after the first in each collision, skipping numbers that give an output in use
+func (r BatchRules) addSuffixes(names, out []string, collisions []Collision) {
+	taken := map[string]bool{}
+	for _, o := range out {
+		taken[r.key(o)] = true
+	}
+	for _, c := range collisions {
+		n := 2
+		for _, i := range c.Indexes[1:] {
+			candidate := r.numbered(names[i], out[i], n)
+			for taken[r.key(candidate)] {
+				n++
+				candidate = r.numbered(names[i], out[i], n)
+			}
+			taken[r.key(candidate)] = true
+			out[i] = candidate
+			n++
+		}
+	}
+}
+
+// numbered returns the output of the name with the number added, with the Separator if there is one, or else by the
+// style (falling back to "_" for styles that drop the number)
+func (r BatchRules) numbered(name, output string, n int) string {
+	num := strconv.Itoa(n)
+	if r.Separator != "" {
+		return output + r.Separator + num
+	}
+	if s := r.Style(name + " " + num); s != output {
+		return s
+	}
+	return output + "_" + num
+}
+
+// key returns the output as compared for collisions
+func (r BatchRules) key(s string) string {
+	if r.IgnoreCase {
+		return strings.ToLower(s)
+	}
+	return s
+}
+
+// quoteAll returns the strings quoted and separated by commas
+func quoteAll(s []string) string {
+	q := make([]string, len(s))
+	for i, x := range s {
+		q[i] = strconv.Quote(x)
+	}
+	return strings.Join(q, ", ")
+}
@@ -0,0 +1,172 @@
+package wordcase
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDetectCollisions provides unit test coverage for DetectCollisions()
+func TestDetectCollisions(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Combiner
+		names []string
+		want  []Collision
+	}{
+		{name: "none", style: SnakeCase, names: []string{"userID", "name"}},
+		{name: "empty", style: SnakeCase, names: nil},
+		{name: "empty outputs", style: SnakeCase, names: []string{"!!", "??"}},
+		{
+			name:  "groups",
+			style: SnakeCase,
+			names: []string{"userID", "a-b", "name", "user_id", "a.b", "UserID"},
+			want: []Collision{
+				{Output: "user_id", Names: []string{"userID", "user_id", "UserID"}, Indexes: []int{0, 3, 5}},
+				{Output: "a_b", Names: []string{"a-b", "a.b"}, Indexes: []int{1, 4}},
+			},
+		},
+		{
+			name:  "repeated name",
+			style: KebabCase,
+			names: []string{"x", "x"},
+			want:  []Collision{{Output: "x", Names: []string{"x", "x"}, Indexes: []int{0, 1}}},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := DetectCollisions(tt.style, tt.names)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestBatchRules_Convert provides unit test coverage for BatchRules.Convert()
+func TestBatchRules_Convert(t *testing.T) {
+	noDigits := func(s string) string { return SnakeCase(dropRunes(unicode.IsDigit)(s)) }
+	tests := []struct {
+		name           string
+		rules          BatchRules
+		names          []string
+		want           []string
+		wantCollisions int
+		wantErr        string
+	}{
+		{
+			name:  "no collisions",
+			rules: BatchRules{Style: SnakeCase},
+			names: []string{"userID", "firstName"},
+			want:  []string{"user_id", "first_name"},
+		},
+		{
+			name:           "error",
+			rules:          BatchRules{Style: SnakeCase},
+			names:          []string{"userID", "user_id", "a-b", "a.b"},
+			want:           []string{"user_id", "user_id", "a_b", "a_b"},
+			wantCollisions: 2,
+			wantErr:        `names collide: "user_id" from "userID", "user_id"; "a_b" from "a-b", "a.b"`,
+		},
+		{
+			name:           "numeric suffix",
+			rules:          BatchRules{Style: SnakeCase, OnCollision: NumericSuffix},
+			names:          []string{"userID", "user_id", "UserId", "name"},
+			want:           []string{"user_id", "user_id_2", "user_id_3", "name"},
+			wantCollisions: 1,
+		},
+		{
+			name:           "numeric suffix skips outputs in use",
+			rules:          BatchRules{Style: SnakeCase, OnCollision: NumericSuffix},
+			names:          []string{"userID", "user_id", "user_id_2"},
+			want:           []string{"user_id", "user_id_3", "user_id_2"},
+			wantCollisions: 1,
+		},
+		{
+			name:           "numeric suffix separator",
+			rules:          BatchRules{Style: KebabCase, OnCollision: NumericSuffix, Separator: "-"},
+			names:          []string{"a_b", "a.b"},
+			want:           []string{"a-b", "a-b-2"},
+			wantCollisions: 1,
+		},
+		{
+			name:           "numeric suffix in camel case",
+			rules:          BatchRules{Style: CamelCase, OnCollision: NumericSuffix},
+			names:          []string{"user_id", "userID", "UserId"},
+			want:           []string{"userID", "userID2", "userID3"},
+			wantCollisions: 1,
+		},
+		{
+			name:           "numeric suffix in screaming snake case",
+			rules:          BatchRules{Style: ScreamingSnakeCase, OnCollision: NumericSuffix},
+			names:          []string{"userID", "user_id", "USER_ID_2"},
+			want:           []string{"USER_ID", "USER_ID_3", "USER_ID_2"},
+			wantCollisions: 1,
+		},
+		{
+			name:           "numeric suffix separator outside the style",
+			rules:          BatchRules{Style: PascalCase, OnCollision: NumericSuffix, Separator: "_"},
+			names:          []string{"user_id", "userID"},
+			want:           []string{"UserID", "UserID_2"},
+			wantCollisions: 1,
+		},
+		{
+			name:           "numeric suffix for a style that drops digits",
+			rules:          BatchRules{Style: noDigits, OnCollision: NumericSuffix},
+			names:          []string{"ab", "a1b"},
+			want:           []string{"ab", "ab_2"},
+			wantCollisions: 1,
+		},
+		{
+			name:  "empty outputs don't collide",
+			rules: BatchRules{Style: SnakeCase},
+			names: []string{"!!", "??", "name"},
+			want:  []string{"", "", "name"},
+		},
+		{
+			name:           "keep first",
+			rules:          BatchRules{Style: SnakeCase, OnCollision: KeepFirst},
+			names:          []string{"name", "userID", "user_id"},
+			want:           []string{"name", "user_id", ""},
+			wantCollisions: 1,
+		},
+		{
+			name:  "case matters",
+			rules: BatchRules{Style: Words},
+			names: []string{"Name", "name"},
+			want:  []string{"Name", "name"},
+		},
+		{
+			name:           "ignore case",
+			rules:          BatchRules{Style: Words, OnCollision: NumericSuffix, IgnoreCase: true},
+			names:          []string{"Name", "name", "name_2", "NAME_2"},
+			want:           []string{"Name", "name 3", "name 2", "NAME 2 2"},
+			wantCollisions: 2,
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, collisions, err := tt.rules.Convert(tt.names)
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, collisions, tt.wantCollisions)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestCollisionStrategy_String provides unit test coverage for CollisionStrategy.String()
+func TestCollisionStrategy_String(t *testing.T) {
+	assert.Equal(t, "error on collision", ErrorOnCollision.String())
+	assert.Equal(t, "numeric suffix", NumericSuffix.String())
+	assert.Equal(t, "keep first", KeepFirst.String())
+	assert.Equal(t, "unknown strategy", CollisionStrategy(9).String())
+}
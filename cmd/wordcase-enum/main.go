@@ -0,0 +1,77 @@
+// Command wordcase-enum generates String, MarshalText and UnmarshalText methods, and an All<Type> function, for enum
+// types, with the names of the values derived from the constant identifiers in a wordcase style.
+//
+// Usage:
+//
+//	wordcase-enum -type=Color[,Other...] [flags] [package directory]
+//
+// Typically used with go:generate, eg
+//
+//	//go:generate wordcase-enum -type=Color -trimprefix=Color -style=kebab
+//
+// UnmarshalText accepts the names written in any case, eg "dark-red", "DARK_RED" and "darkRed" are all ColorDarkRed.
+// The generated file imports this module to find the canonical form of the names.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mantidtech/wordcase"
+	"github.com/mantidtech/wordcase/enumgen"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stderr))
+}
+
+// run processes the command line, returning the exit code
+func run(args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("wordcase-enum", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	typeNames := fs.String("type", "", "comma separated list of type names; required")
+	style := fs.String("style", "kebab", "the style of the value names, one of: "+strings.Join(wordcase.StyleNames(), ", "))
+	trimPrefix := fs.String("trimprefix", "", "the prefix to remove from the constant names")
+	output := fs.String("output", "", "the output file; default <dir>/<type>_wordcase.go")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *typeNames == "" || fs.NArg() > 1 {
+		fmt.Fprintln(stderr, "usage: wordcase-enum -type=Type[,Type...] [flags] [package directory]")
+		return 2
+	}
+	c, ok := wordcase.StyleByName(*style)
+	if !ok {
+		fmt.Fprintf(stderr, "unknown style %q\n", *style)
+		return 2
+	}
+
+	dir := "."
+	if fs.NArg() == 1 {
+		dir = fs.Arg(0)
+	}
+	types := strings.Split(*typeNames, ",")
+	if *output == "" {
+		*output = filepath.Join(dir, enumgen.OutputName(types[0]))
+	}
+
+	opts := enumgen.Options{
+		Style:      c,
+		TrimPrefix: *trimPrefix,
+		Command:    "wordcase-enum " + strings.Join(args, " "),
+	}
+	src, err := enumgen.GenerateDir(dir, *output, types, opts)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
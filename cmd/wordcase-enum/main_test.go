@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRun provides unit test coverage for run()
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\ntype Level int\n\nconst (\n\tLevelDebug Level = iota\n\tLevelWarn\n)\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o600))
+
+	var stderr bytes.Buffer
+	code := run([]string{"-type=Level", "-trimprefix=Level", "-style=SCREAMING_SNAKE", dir}, &stderr)
+	assert.Equal(t, 0, code, stderr.String())
+	got, err := os.ReadFile(filepath.Join(dir, "level_wordcase.go"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(got), "LevelDebug: \"DEBUG\",")
+
+	out := filepath.Join(dir, "levels.go")
+	code = run([]string{"-type=Level", "-output", out, dir}, &stderr)
+	assert.Equal(t, 0, code, stderr.String())
+	got, err = os.ReadFile(out)
+	assert.NoError(t, err)
+	assert.Contains(t, string(got), "LevelDebug: \"level-debug\",")
+
+	code = run([]string{"-type=Missing", dir}, &stderr)
+	assert.Equal(t, 1, code)
+
+	code = run([]string{"-type=Level", "-style=sponge", dir}, &stderr)
+	assert.Equal(t, 2, code)
+
+	code = run([]string{dir}, &stderr)
+	assert.Equal(t, 2, code)
+
+	code = run([]string{"-bad-flag"}, &stderr)
+	assert.Equal(t, 2, code)
+}
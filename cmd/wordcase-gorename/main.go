@@ -0,0 +1,95 @@
+// Command wordcase-gorename renames identifiers in a Go package that don't follow Go naming conventions,
+// eg snake_case local variables, updating their declarations and every use.
+//
+// Usage:
+//
+//	wordcase-gorename [flags] [package directory ...]
+//
+// By default the renames are only shown; use -w to make them in the source files, which are then gofmt'd.
+// Identifiers that can't be renamed safely (eg because the new name would collide with, or shadow, another) are
+// reported and left alone.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/mantidtech/wordcase/gorename"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run processes the command line, returning the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("wordcase-gorename", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	write := fs.Bool("w", false, "write the changes to the source files instead of showing the renames")
+	exported := fs.Bool("exported", false, "also rename exported identifiers, changing the package API")
+	lint := fs.Bool("lint", false, "also require initialisms to be same-cased (eg userId -> userID)")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	conv := gorename.MixedCaps
+	if *lint {
+		conv = gorename.Lint
+	}
+	opts := gorename.Options{Exported: *exported}
+
+	dirs := fs.Args()
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+
+	code := 0
+	for _, dir := range dirs {
+		if err := renameDir(dir, conv, opts, *write, stdout, stderr); err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", dir, err)
+			code = 1
+		}
+	}
+	return code
+}
+
+// renameDir renames the identifiers in the package in the given directory
+func renameDir(dir string, conv gorename.Convention, opts gorename.Options, write bool, stdout, stderr io.Writer) error {
+	pkg, err := gorename.Load(dir)
+	if err != nil {
+		return err
+	}
+
+	renames, refusals := pkg.Plan(conv, opts)
+	for _, r := range refusals {
+		fmt.Fprintf(stderr, "%s: not renaming %s to %s: %s\n", pkg.Fset.Position(r.Object.Pos()), r.From, r.To, r.Reason)
+	}
+
+	if !write {
+		for _, r := range renames {
+			fmt.Fprintf(stdout, "%s: %s -> %s\n", pkg.Fset.Position(r.Object.Pos()), r.From, r.To)
+		}
+		return nil
+	}
+
+	changed, err := pkg.Apply(renames)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(changed))
+	for name := range changed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := os.WriteFile(name, changed[name], 0o644); err != nil { // #nosec G306 -- replacing an existing source file
+			return err
+		}
+		fmt.Fprintf(stdout, "updated %s\n", filepath.ToSlash(name))
+	}
+	return nil
+}
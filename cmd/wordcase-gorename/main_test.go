@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRun provides unit test coverage for run()
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "p.go")
+	src := "package p\n\nfunc f(user_id int) int { return user_id }\n\nvar Max_Size = 1\n"
+	assert.NoError(t, os.WriteFile(file, []byte(src), 0o600))
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{dir}, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout.String(), "p.go:3:8: user_id -> userID")
+	assert.Contains(t, stderr.String(), "not renaming Max_Size to MaxSize")
+
+	got, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, src, string(got), "a dry run doesn't change files")
+
+	stdout.Reset()
+	stderr.Reset()
+	code = run([]string{"-w", "-exported", dir}, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Empty(t, stderr.String())
+	got, err = os.ReadFile(file)
+	assert.NoError(t, err)
+	assert.Equal(t, "package p\n\nfunc f(userID int) int { return userID }\n\nvar MaxSize = 1\n", string(got))
+
+	code = run([]string{filepath.Join(dir, "missing")}, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+
+	code = run([]string{"-bad-flag"}, &stdout, &stderr)
+	assert.Equal(t, 2, code)
+}
@@ -0,0 +1,102 @@
+// Command wordcase-lint reports Go identifiers, struct tag names and string constant values that don't follow the
+// configured case styles.
+//
+// Usage:
+//
+//	wordcase-lint [flags] [directory ...]
+//
+// Rules are given as selector=style, eg -rule tag.json=snake -rule tag.env=screaming-snake -rule exported=go-exported.
+// Without any rules, identifiers are checked against the Go naming conventions.
+// A directory ending in "/..." is checked recursively.
+//
+// The exit code is 0 if no problems were found, 1 if some were, and 2 on error.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mantidtech/wordcase"
+	"github.com/mantidtech/wordcase/namelint"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// ruleFlags collects the rules given on the command line
+type ruleFlags []namelint.Rule
+
+// String implements flag.Value
+func (r *ruleFlags) String() string {
+	s := make([]string, len(*r))
+	for i, rule := range *r {
+		s[i] = rule.Selector + "=" + rule.Style
+	}
+	return strings.Join(s, " ")
+}
+
+// Set implements flag.Value
+func (r *ruleFlags) Set(s string) error {
+	rule, err := namelint.ParseRule(s)
+	if err != nil {
+		return err
+	}
+	*r = append(*r, rule)
+	return nil
+}
+
+var writers = map[string]func(io.Writer, []namelint.Report) error{
+	"text":  namelint.WriteText,
+	"json":  namelint.WriteJSON,
+	"sarif": namelint.WriteSARIF,
+}
+
+// run processes the command line, returning the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("wordcase-lint", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var rules ruleFlags
+	fs.Var(&rules, "rule", "a rule as selector=style, may be repeated (styles: "+strings.Join(wordcase.StyleNames(), ", ")+")")
+	format := fs.String("format", "text", "the output format: text, json or sarif")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	write, ok := writers[*format]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown format %q\n", *format)
+		return 2
+	}
+	if len(rules) == 0 {
+		rules = namelint.DefaultRules
+	}
+
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	c := namelint.NewChecker(rules)
+	var reports []namelint.Report
+	for _, p := range paths {
+		r, err := c.CheckPath(p)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", p, err)
+			return 2
+		}
+		reports = append(reports, r...)
+	}
+
+	if err := write(stdout, reports); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	if len(reports) > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRun provides unit test coverage for run()
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\ntype T struct {\n\tUserID int `json:\"userId\"`\n}\n\nfunc Serve_Http() {}\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o600))
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{dir}, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	assert.Equal(t, filepath.Join(dir, "p.go")+":7:6: func \"Serve_Http\" should be \"ServeHTTP\" (exported=go-lint)\n", stdout.String())
+
+	stdout.Reset()
+	code = run([]string{"-rule", "tag.json=snake", "-format", "json", dir}, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout.String(), `"want": "user_id"`)
+	assert.NotContains(t, stdout.String(), "Serve_Http")
+
+	stdout.Reset()
+	code = run([]string{"-rule", "func=snake", "-format", "sarif", dir}, &stdout, &stderr)
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stdout.String(), `"ruleId": "func=snake"`)
+
+	stdout.Reset()
+	code = run([]string{"-rule", "type=pascal", dir}, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Empty(t, stdout.String())
+
+	code = run([]string{"-format", "xml", dir}, &stdout, &stderr)
+	assert.Equal(t, 2, code)
+
+	code = run([]string{"-rule", "func=sponge", dir}, &stdout, &stderr)
+	assert.Equal(t, 2, code)
+
+	code = run([]string{filepath.Join(dir, "missing")}, &stdout, &stderr)
+	assert.Equal(t, 2, code)
+}
@@ -0,0 +1,68 @@
+// Command wordcase applies wordcase styles to things other than Go source.
+//
+// Usage:
+//
+//	wordcase <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	rename    rename the files (and optionally directories) in a tree to a style
+//
+// Run "wordcase <command> -h" for the flags of a command.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// commands are the subcommands, by name
+var commands = map[string]func(args []string, stdout, stderr io.Writer) int{
+	"rename": runRename,
+}
+
+// run processes the command line, returning the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		usage(stderr)
+		return 2
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "wordcase: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+	return cmd(args[1:], stdout, stderr)
+}
+
+// usage writes the list of commands
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Fprintf(w, "usage: wordcase <command> [flags] [arguments]\ncommands: %s\n", strings.Join(names, ", "))
+}
+
+// stringsFlag collects the values of a repeatable flag
+type stringsFlag []string
+
+// String implements flag.Value
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+// Set implements flag.Value
+func (s *stringsFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRun provides unit test coverage for run()
+func TestRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 2, run(nil, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "commands: rename")
+
+	stderr.Reset()
+	assert.Equal(t, 2, run([]string{"frobnicate"}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), `unknown command "frobnicate"`)
+
+	stderr.Reset()
+	assert.Equal(t, 0, run([]string{"rename", t.TempDir()}, &stdout, &stderr))
+	assert.Empty(t, stderr.String())
+}
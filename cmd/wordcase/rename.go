@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mantidtech/wordcase"
+	"github.com/mantidtech/wordcase/filerename"
+)
+
+// runRename renames the files in the given directories to a style.
+//
+//	By default the renames are only shown; -w makes them, and -manifest records them so -undo can reverse them later
+func runRename(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("wordcase rename", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	styleName := fs.String("style", "kebab", "the style for names, without their extension (styles: "+strings.Join(wordcase.StyleNames(), ", ")+")")
+	write := fs.Bool("w", false, "rename the files instead of showing the renames")
+	dirs := fs.Bool("dirs", false, "also rename directories")
+	lowerExt := fs.Bool("lower-ext", false, "also convert extensions to lowercase")
+	manifest := fs.String("manifest", "", "with -w, record the renames in this file, for -undo")
+	undo := fs.String("undo", "", "reverse the renames recorded in this manifest file, instead of planning new ones")
+	var include, exclude stringsFlag
+	fs.Var(&include, "include", "only rename entries whose name or relative path matches this glob, may be repeated")
+	fs.Var(&exclude, "exclude", "skip entries whose name or relative path matches this glob, may be repeated")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	style, ok := wordcase.StyleByName(*styleName)
+	if !ok {
+		fmt.Fprintf(stderr, "unknown style %q\n", *styleName)
+		return 2
+	}
+	opts := filerename.Options{Style: style, LowerExtension: *lowerExt, Dirs: *dirs, Include: include, Exclude: exclude}
+
+	var renames []filerename.Rename
+	code := 0
+	if *undo != "" {
+		r, err := readManifest(*undo)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		renames = r
+	} else {
+		roots := fs.Args()
+		if len(roots) == 0 {
+			roots = []string{"."}
+		}
+		for _, root := range roots {
+			r, collisions, err := filerename.Plan(root, opts)
+			if err != nil {
+				fmt.Fprintf(stderr, "%s: %v\n", root, err)
+				code = 1
+				continue
+			}
+			for _, c := range collisions {
+				fmt.Fprintln(stderr, c.Error())
+			}
+			renames = append(renames, r...)
+		}
+	}
+
+	if !*write {
+		for _, r := range renames {
+			fmt.Fprintf(stdout, "%s -> %s\n", r.From, r.To)
+		}
+		return code
+	}
+
+	done, err := filerename.Apply(renames)
+	for _, r := range done {
+		fmt.Fprintf(stdout, "renamed %s -> %s\n", r.From, r.To)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		code = 1
+	}
+	if *manifest != "" {
+		if err := writeManifest(*manifest, done); err != nil {
+			fmt.Fprintln(stderr, err)
+			code = 1
+		}
+	}
+	return code
+}
+
+// readManifest returns the renames that undo those recorded in the manifest file
+func readManifest(name string) ([]filerename.Rename, error) {
+	b, err := os.ReadFile(name) // #nosec G304 -- the file is named on the command line
+	if err != nil {
+		return nil, err
+	}
+	return filerename.ReadManifest(bytes.NewReader(b))
+}
+
+// writeManifest records the renames in the manifest file
+func writeManifest(name string, renames []filerename.Rename) error {
+	f, err := os.Create(name) // #nosec G304 -- the file is named on the command line
+	if err != nil {
+		return err
+	}
+	if err := filerename.WriteManifest(f, renames); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
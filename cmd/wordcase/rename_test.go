@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRunRename provides unit test coverage for runRename()
+func TestRunRename(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"MyComponent.tsx", "Some Photo.JPG", "some_photo.jpg.bak", "foo_bar.txt", "FooBar.txt"} {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+	manifest := filepath.Join(t.TempDir(), "manifest.json")
+
+	var stdout, stderr bytes.Buffer
+	code := runRename([]string{"-style", "snake", "-lower-ext", "-exclude", "*.bak", dir}, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout.String(), filepath.Join(dir, "MyComponent.tsx")+" -> "+filepath.Join(dir, "my_component.tsx"))
+	assert.Contains(t, stdout.String(), filepath.Join(dir, "Some Photo.JPG")+" -> "+filepath.Join(dir, "some_photo.jpg"))
+	assert.Contains(t, stderr.String(), "not renaming "+filepath.Join(dir, "FooBar.txt")+" to foo_bar.txt: collides with")
+	assert.FileExists(t, filepath.Join(dir, "MyComponent.tsx"), "a dry run doesn't rename files")
+
+	stdout.Reset()
+	stderr.Reset()
+	code = runRename([]string{"-style", "snake", "-lower-ext", "-exclude", "*.bak", "-w", "-manifest", manifest, dir}, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout.String(), "renamed ")
+	assert.FileExists(t, filepath.Join(dir, "my_component.tsx"))
+	assert.FileExists(t, filepath.Join(dir, "some_photo.jpg"))
+	assert.FileExists(t, filepath.Join(dir, "FooBar.txt"))
+	assert.FileExists(t, manifest)
+
+	stdout.Reset()
+	code = runRename([]string{"-undo", manifest}, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout.String(), filepath.Join(dir, "my_component.tsx")+" -> "+filepath.Join(dir, "MyComponent.tsx"))
+	assert.FileExists(t, filepath.Join(dir, "my_component.tsx"), "a dry run doesn't undo renames")
+
+	code = runRename([]string{"-undo", manifest, "-w"}, &stdout, &stderr)
+	assert.Equal(t, 0, code)
+	assert.FileExists(t, filepath.Join(dir, "MyComponent.tsx"))
+	assert.FileExists(t, filepath.Join(dir, "Some Photo.JPG"))
+}
+
+// TestRunRename_errors provides unit test coverage for runRename() failures
+func TestRunRename_errors(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 2, runRename([]string{"-bad-flag"}, &stdout, &stderr))
+	assert.Equal(t, 2, runRename([]string{"-style", "wobbly"}, &stdout, &stderr))
+	assert.Equal(t, 1, runRename([]string{"-undo", filepath.Join(t.TempDir(), "missing.json")}, &stdout, &stderr))
+	assert.Equal(t, 1, runRename([]string{filepath.Join(t.TempDir(), "missing")}, &stdout, &stderr))
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "A.txt"), nil, 0o600))
+	manifest := filepath.Join(t.TempDir(), "missing", "manifest.json")
+	assert.Equal(t, 1, runRename([]string{"-w", "-manifest", manifest, dir}, &stdout, &stderr))
+}
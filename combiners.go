@@ -2,12 +2,87 @@ package wordcase
 
 import (
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Combiner is function that joins tokens together to create the final output
 type Combiner func(string) string
 
+// GlueFn decides the glue to place between two adjacent tokens
+type GlueFn func(prev, next string) string
+
 // Join concatenates tokens into a string with the given separator
 func (t Tokens) Join(sep string) string {
 	return strings.Join(t, sep)
 }
+
+// JoinUsing concatenates tokens into a string, asking glue for the separator at each token boundary
+func (t Tokens) JoinUsing(glue GlueFn) string {
+	var b strings.Builder
+	for i, x := range t {
+		if i > 0 {
+			b.WriteString(glue(t[i-1], x))
+		}
+		b.WriteString(x)
+	}
+	return b.String()
+}
+
+// SepUnlessDigit returns a GlueFn that uses sep between tokens, except where the next token starts with a digit,
+//
+//	in which case the tokens are joined directly (eg "ab", "c", "1" -> "ab_c1")
+func SepUnlessDigit(sep string) GlueFn {
+	return func(_, next string) string {
+		r, _ := utf8.DecodeRuneInString(next)
+		if unicode.IsDigit(r) {
+			return ""
+		}
+		return sep
+	}
+}
+
+// WithPrefix returns a Combiner that prepends the given prefix to the output of c
+func (c Combiner) WithPrefix(prefix string) Combiner {
+	return func(s string) string {
+		return prefix + c(s)
+	}
+}
+
+// WithSuffix returns a Combiner that appends the given suffix to the output of c
+func (c Combiner) WithSuffix(suffix string) Combiner {
+	return func(s string) string {
+		return c(s) + suffix
+	}
+}
+
+// WrapWith returns a Combiner that surrounds the output of c with left and right
+func (c Combiner) WrapWith(left, right string) Combiner {
+	return func(s string) string {
+		return left + c(s) + right
+	}
+}
+
+// Quote returns a Combiner that surrounds the output of c with the quote string q.
+//
+//	Any occurrences of q already in the output are escaped by doubling them, as is done for SQL identifiers
+func (c Combiner) Quote(q string) Combiner {
+	return func(s string) string {
+		r := c(s)
+		if q != "" {
+			r = strings.ReplaceAll(r, q, q+q)
+		}
+		return q + r + q
+	}
+}
+
+// ThenFormat returns a Combiner that applies each of the given formatters, in order, to the output of c
+func (c Combiner) ThenFormat(formatters ...Formatter) Combiner {
+	return func(s string) string {
+		r := c(s)
+		for _, fn := range formatters {
+			r = fn(r)
+		}
+		return r
+	}
+}
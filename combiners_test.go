@@ -1,7 +1,9 @@
 package wordcase
 
 import (
+	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -41,3 +43,128 @@ func TestTokens_Join(t *testing.T) {
 		})
 	}
 }
+
+// TestTokens_JoinUsing provides unit test coverage for Tokens.JoinUsing()
+func TestTokens_JoinUsing(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Tokens
+		glue GlueFn
+		want string
+	}{
+		{
+			name: "empty",
+			t:    Tokens{},
+			glue: SepUnlessDigit("_"),
+			want: "",
+		},
+		{
+			name: "simple",
+			t:    Tokens{"simple"},
+			glue: SepUnlessDigit("_"),
+			want: "simple",
+		},
+		{
+			name: "digits joined directly",
+			t:    Tokens{"ab", "c", "1"},
+			glue: SepUnlessDigit("_"),
+			want: "ab_c1",
+		},
+		{
+			name: "digits leading",
+			t:    Tokens{"1", "ab"},
+			glue: SepUnlessDigit("_"),
+			want: "1_ab",
+		},
+		{
+			name: "uses neighbours",
+			t:    Tokens{"a", "b", "c"},
+			glue: func(prev, next string) string { return prev + next },
+			want: "aabbbcc",
+		},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.t.JoinUsing(tt.glue)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestCombiner_Wrappers provides unit test coverage for the Combiner decorators
+func TestCombiner_Wrappers(t *testing.T) {
+	snake := NewPipeline().
+		TokenizeUsing(SimpleCategorizer, unicode.IsSpace, true).
+		JoinWith("_")
+
+	tests := []struct {
+		name string
+		c    Combiner
+		s    string
+		want string
+	}{
+		{
+			name: "prefix",
+			c:    snake.WithPrefix("$"),
+			s:    "user id",
+			want: "$user_id",
+		},
+		{
+			name: "suffix",
+			c:    snake.WithSuffix("_"),
+			s:    "user id",
+			want: "user_id_",
+		},
+		{
+			name: "wrap",
+			c:    snake.WrapWith("__", "__"),
+			s:    "private name",
+			want: "__private_name__",
+		},
+		{
+			name: "quote",
+			c:    snake.Quote(`"`),
+			s:    "quoted column",
+			want: `"quoted_column"`,
+		},
+		{
+			name: "quote escapes",
+			c:    snake.Quote(`"`),
+			s:    `say "hi"`,
+			want: `"say_""hi"""`,
+		},
+		{
+			name: "quote empty",
+			c:    snake.Quote(""),
+			s:    "a b",
+			want: "a_b",
+		},
+		{
+			name: "then format",
+			c:    snake.ThenFormat(strings.ToUpper, UppercaseFirst),
+			s:    "one two",
+			want: "ONE_TWO",
+		},
+		{
+			name: "then format nothing",
+			c:    snake.ThenFormat(),
+			s:    "one two",
+			want: "one_two",
+		},
+		{
+			name: "chained",
+			c:    snake.ThenFormat(strings.ToUpper).WithPrefix("APP_"),
+			s:    "max conns",
+			want: "APP_MAX_CONNS",
+		},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.c(tt.s))
+		})
+	}
+}
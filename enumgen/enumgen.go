@@ -0,0 +1,235 @@
+// Package enumgen generates String, MarshalText and UnmarshalText methods for enum types, with names in a wordcase style
+package enumgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/mantidtech/wordcase"
+)
+
+// Options control how constant names become enum names
+type Options struct {
+	Style      wordcase.Combiner // converts the constant names, after the prefix is removed
+	TrimPrefix string            // removed from the start of the constant names, eg "Color" for ColorRed, ColorGreen
+	Command    string            // the command line recorded in the generated file's header, eg "wordcase-enum -type=Color"
+}
+
+// Enum is a type, and the constants declared with it
+type Enum struct {
+	Name       string  // the type name
+	Underlying string  // the underlying basic type, eg "int"
+	Values     []Value // in the order they're declared
+}
+
+// Value is one constant of an enum type
+type Value struct {
+	Ident string // the constant identifier, eg "ColorDarkRed"
+	Text  string // the text it's marshalled as, eg "dark-red"
+	First bool   // true if this is the first constant with its value, so it appears in All and String
+}
+
+// Key is the canonical form of a name that text is unmarshalled by, so any case variant of a name is accepted,
+// including initialisms followed by digits, eg "utf8" and "UTF8" (see wordcase.Key)
+func Key(name string) string {
+	return wordcase.Key(name)
+}
+
+// Package is a parsed and type checked Go package
+type Package struct {
+	Name  string
+	Types *types.Package
+}
+
+// Load parses and type checks the package in the given directory, ignoring the named files (eg a previously generated output).
+//
+//	Type errors are tolerated, as other files may refer to code that's yet to be generated
+func Load(dir string, ignore ...string) (*Package, error) {
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := wordcase.NewWordSet(ignore...)
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range bp.GoFiles {
+		if skip.Has(name) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {},
+	}
+	pkg, _ := conf.Check(bp.ImportPath, fset, files, nil)
+	return &Package{Name: bp.Name, Types: pkg}, nil
+}
+
+// Enum finds the constants of the named type
+func (p *Package) Enum(typeName string, opts Options) (Enum, error) {
+	obj, ok := p.Types.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return Enum{}, fmt.Errorf("type %s not found in package %s", typeName, p.Name)
+	}
+	basic, ok := obj.Type().Underlying().(*types.Basic)
+	if !ok || basic.Info()&(types.IsInteger|types.IsString) == 0 {
+		return Enum{}, fmt.Errorf("type %s must have an integer or string underlying type", typeName)
+	}
+
+	var consts []*types.Const
+	for _, name := range p.Types.Scope().Names() {
+		if c, ok := p.Types.Scope().Lookup(name).(*types.Const); ok && types.Identical(c.Type(), obj.Type()) {
+			consts = append(consts, c)
+		}
+	}
+	if len(consts) == 0 {
+		return Enum{}, fmt.Errorf("no constants of type %s found", typeName)
+	}
+	sort.Slice(consts, func(i, j int) bool { return consts[i].Pos() < consts[j].Pos() })
+
+	e := Enum{Name: typeName, Underlying: basic.Name()}
+	seenValue := make(map[string]bool)
+	seenKey := make(map[string]string)
+	for _, c := range consts {
+		text := strings.TrimPrefix(c.Name(), opts.TrimPrefix)
+		if opts.Style != nil {
+			text = opts.Style(text)
+		}
+		if text == "" {
+			return Enum{}, fmt.Errorf("constant %s has no name once the prefix %q is removed", c.Name(), opts.TrimPrefix)
+		}
+		key := Key(text)
+		if prev, ok := seenKey[key]; ok {
+			return Enum{}, fmt.Errorf("constants %s and %s have the same name %q, ignoring case", prev, c.Name(), text)
+		}
+		seenKey[key] = c.Name()
+
+		val := c.Val().ExactString()
+		e.Values = append(e.Values, Value{Ident: c.Name(), Text: text, First: !seenValue[val]})
+		seenValue[val] = true
+	}
+	return e, nil
+}
+
+// Generate generates the source for the given enums, in the package
+func Generate(pkgName string, enums []Enum, opts Options) ([]byte, error) {
+	var b bytes.Buffer
+	err := fileTemplate.Execute(&b, struct {
+		Command string
+		Package string
+		Enums   []Enum
+	}{
+		Command: opts.Command,
+		Package: pkgName,
+		Enums:   enums,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(b.Bytes())
+}
+
+// GenerateDir loads the package in the directory and generates the source for the named types.
+// The output file is ignored when loading, as it may be out of date
+func GenerateDir(dir, output string, typeNames []string, opts Options) ([]byte, error) {
+	p, err := Load(dir, filepath.Base(output))
+	if err != nil {
+		return nil, err
+	}
+	enums := make([]Enum, 0, len(typeNames))
+	for _, name := range typeNames {
+		e, err := p.Enum(name, opts)
+		if err != nil {
+			return nil, err
+		}
+		enums = append(enums, e)
+	}
+	return Generate(p.Name, enums, opts)
+}
+
+// OutputName is the default file name for the generated source, eg "color_wordcase.go" for the type Color
+func OutputName(typeName string) string {
+	return wordcase.SnakeCase(typeName) + "_wordcase.go"
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by {{ or .Command "wordcase-enum" }}; DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"fmt"
+
+	"github.com/mantidtech/wordcase"
+)
+{{ range .Enums }}{{ $t := .Name }}
+// _{{ $t }}Text is the text of each {{ $t }} value
+var _{{ $t }}Text = map[{{ $t }}]string{
+{{- range .Values }}{{ if .First }}
+	{{ .Ident }}: {{ printf "%q" .Text }},{{ end }}{{ end }}
+}
+
+// _{{ $t }}ByKey finds a {{ $t }} value by the canonical form of its text
+var _{{ $t }}ByKey = func() map[string]{{ $t }} {
+	m := make(map[string]{{ $t }})
+	for text, val := range map[string]{{ $t }}{
+{{- range .Values }}
+		{{ printf "%q" .Text }}: {{ .Ident }},{{ end }}
+	} {
+		m[wordcase.Key(text)] = val
+	}
+	return m
+}()
+
+// All{{ $t }} returns the values of {{ $t }}, in the order they're declared
+func All{{ $t }}() []{{ $t }} {
+	return []{{ $t }}{
+{{- range .Values }}{{ if .First }}
+		{{ .Ident }},{{ end }}{{ end }}
+	}
+}
+
+// String returns the text of the value
+func (v {{ $t }}) String() string {
+	if s, ok := _{{ $t }}Text[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("{{ $t }}(%v)", {{ .Underlying }}(v))
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (v {{ $t }}) MarshalText() ([]byte, error) {
+	s, ok := _{{ $t }}Text[v]
+	if !ok {
+		return nil, fmt.Errorf("invalid {{ $t }} %v", {{ .Underlying }}(v))
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the text of a value written in any case
+func (v *{{ $t }}) UnmarshalText(text []byte) error {
+	s, ok := _{{ $t }}ByKey[wordcase.Key(string(text))]
+	if !ok {
+		return fmt.Errorf("invalid {{ $t }} %q", text)
+	}
+	*v = s
+	return nil
+}
+{{ end }}`))
@@ -0,0 +1,292 @@
+package enumgen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mantidtech/wordcase"
+)
+
+const testSource = `package colors
+
+type Color int
+
+const (
+	ColorRed Color = iota
+	ColorDarkRed
+	ColorHTTPBlue
+	ColorDefault = ColorRed
+)
+
+type Mode string
+
+const (
+	ModeReadOnly  Mode = "ro"
+	ModeReadWrite Mode = "rw"
+)
+
+type Clash int
+
+const (
+	ClashOne Clash = iota
+	ClashOne_
+)
+
+type Point struct{ X, Y int }
+
+type Empty int
+
+func uses() []Color { return AllColor() } // refers to code that's yet to be generated
+`
+
+// helperLoad writes the test source to a new directory and loads it
+func helperLoad(t *testing.T) (string, *Package) {
+	t.Helper()
+	dir := t.TempDir()
+	if !assert.NoError(t, os.WriteFile(filepath.Join(dir, "colors.go"), []byte(testSource), 0o600)) {
+		t.FailNow()
+	}
+	p, err := Load(dir)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return dir, p
+}
+
+// TestPackageEnum provides unit test coverage for Load() and Package.Enum()
+func TestPackageEnum(t *testing.T) {
+	_, p := helperLoad(t)
+	assert.Equal(t, "colors", p.Name)
+
+	tests := []struct {
+		name     string
+		typeName string
+		opts     Options
+		want     Enum
+		wantErr  string
+	}{
+		{
+			name:     "kebab without prefix",
+			typeName: "Color",
+			opts:     Options{Style: wordcase.KebabCase, TrimPrefix: "Color"},
+			want: Enum{
+				Name:       "Color",
+				Underlying: "int",
+				Values: []Value{
+					{Ident: "ColorRed", Text: "red", First: true},
+					{Ident: "ColorDarkRed", Text: "dark-red", First: true},
+					{Ident: "ColorHTTPBlue", Text: "http-blue", First: true},
+					{Ident: "ColorDefault", Text: "default", First: false},
+				},
+			},
+		},
+		{
+			name:     "screaming snake string",
+			typeName: "Mode",
+			opts:     Options{Style: wordcase.ScreamingSnakeCase},
+			want: Enum{
+				Name:       "Mode",
+				Underlying: "string",
+				Values: []Value{
+					{Ident: "ModeReadOnly", Text: "MODE_READ_ONLY", First: true},
+					{Ident: "ModeReadWrite", Text: "MODE_READ_WRITE", First: true},
+				},
+			},
+		},
+		{
+			name:     "no style",
+			typeName: "Mode",
+			opts:     Options{TrimPrefix: "Mode"},
+			want: Enum{
+				Name:       "Mode",
+				Underlying: "string",
+				Values: []Value{
+					{Ident: "ModeReadOnly", Text: "ReadOnly", First: true},
+					{Ident: "ModeReadWrite", Text: "ReadWrite", First: true},
+				},
+			},
+		},
+		{
+			name:     "names clash",
+			typeName: "Clash",
+			opts:     Options{Style: wordcase.KebabCase},
+			wantErr:  `constants ClashOne and ClashOne_ have the same name "clash-one", ignoring case`,
+		},
+		{
+			name:     "nothing left after prefix",
+			typeName: "Mode",
+			opts:     Options{TrimPrefix: "ModeReadOnly"},
+			wantErr:  `constant ModeReadOnly has no name once the prefix "ModeReadOnly" is removed`,
+		},
+		{
+			name:     "not a basic type",
+			typeName: "Point",
+			wantErr:  "type Point must have an integer or string underlying type",
+		},
+		{
+			name:     "no constants",
+			typeName: "Empty",
+			wantErr:  "no constants of type Empty found",
+		},
+		{
+			name:     "missing",
+			typeName: "Missing",
+			wantErr:  "type Missing not found in package colors",
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := p.Enum(tt.typeName, tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestGenerateDir provides unit test coverage for GenerateDir() and Generate()
+func TestGenerateDir(t *testing.T) {
+	dir, _ := helperLoad(t)
+	out := filepath.Join(dir, OutputName("Color"))
+	assert.NoError(t, os.WriteFile(out, []byte("package colors\n\nthis is stale"), 0o600))
+
+	opts := Options{Style: wordcase.KebabCase, TrimPrefix: "Color", Command: "wordcase-enum -type=Color"}
+	got, err := GenerateDir(dir, out, []string{"Color"}, opts)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	src := string(got)
+	assert.Contains(t, src, "// Code generated by wordcase-enum -type=Color; DO NOT EDIT.\n\npackage colors\n")
+	assert.Contains(t, src, "\tColorDarkRed:  \"dark-red\",\n")
+	assert.Contains(t, src, "\t\t\"default\":   ColorDefault,\n")
+	assert.Contains(t, src, "func AllColor() []Color {\n\treturn []Color{\n\t\tColorRed,\n\t\tColorDarkRed,\n\t\tColorHTTPBlue,\n\t}\n}")
+	assert.Contains(t, src, `return fmt.Sprintf("Color(%v)", int(v))`)
+	assert.Contains(t, src, "func (v *Color) UnmarshalText(text []byte) error {")
+
+	_, err = GenerateDir(dir, out, []string{"Color", "Missing"}, opts)
+	assert.Error(t, err)
+
+	_, err = GenerateDir(filepath.Join(dir, "missing"), out, []string{"Color"}, opts)
+	assert.Error(t, err)
+}
+
+// TestGenerateDir_enumtest checks the generated code tested in internal/enumtest is what would be generated now
+func TestGenerateDir_enumtest(t *testing.T) {
+	dir := filepath.Join("internal", "enumtest")
+	out := filepath.Join(dir, OutputName("Encoding"))
+	want, err := os.ReadFile(out)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	opts := Options{
+		Style:      wordcase.KebabCase,
+		TrimPrefix: "Encoding",
+		Command:    "wordcase-enum -type=Encoding -trimprefix=Encoding",
+	}
+	got, err := GenerateDir(dir, out, []string{"Encoding"}, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "run go generate in %s", dir)
+}
+
+// TestGenerate_typeChecks checks the generated source parses and type checks along with the package it's generated
+// for, for each combination of options
+func TestGenerate_typeChecks(t *testing.T) {
+	_, p := helperLoad(t)
+	wd, err := os.Getwd()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	styles := map[string]wordcase.Combiner{
+		"none":            nil,
+		"kebab":           wordcase.KebabCase,
+		"screaming-snake": wordcase.ScreamingSnakeCase,
+		"camel":           wordcase.CamelCase,
+	}
+	typeSets := [][]string{{"Color"}, {"Mode"}, {"Color", "Mode"}}
+
+	// the files are named as if they were in this directory, so the wordcase import is found in this module
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+	for styleName, style := range styles {
+		for _, typeNames := range typeSets {
+			for _, trim := range []bool{false, true} {
+				for _, command := range []string{"", "wordcase-enum -type=Color"} {
+					opts := Options{Style: style, Command: command}
+					if trim && len(typeNames) == 1 {
+						opts.TrimPrefix = typeNames[0]
+					}
+					name := fmt.Sprintf("%s %v trim=%q command=%q", styleName, typeNames, opts.TrimPrefix, command)
+
+					enums := make([]Enum, 0, len(typeNames))
+					for _, tn := range typeNames {
+						e, err := p.Enum(tn, opts)
+						if !assert.NoError(t, err, name) {
+							t.FailNow()
+						}
+						enums = append(enums, e)
+					}
+					src, err := Generate(p.Name, enums, opts)
+					if !assert.NoError(t, err, name) {
+						continue
+					}
+
+					files := []*ast.File{
+						helperParse(t, fset, filepath.Join(wd, "testdata", "colors.go"), testSource),
+						helperParse(t, fset, filepath.Join(wd, "testdata", "colors_wordcase.go"), string(src)),
+					}
+					if len(typeNames) == 1 && typeNames[0] == "Mode" {
+						// testSource refers to AllColor, which is only generated for Color
+						files = append(files, helperParse(t, fset, filepath.Join(wd, "testdata", "stub.go"),
+							"package colors\n\nfunc AllColor() []Color { return nil }\n"))
+					}
+
+					conf := types.Config{Importer: imp}
+					_, err = conf.Check("colors", fset, files, nil)
+					assert.NoError(t, err, "%s\n%s", name, src)
+				}
+			}
+		}
+	}
+}
+
+// helperParse parses the source, failing the test on error
+func helperParse(t *testing.T, fset *token.FileSet, filename, src string) *ast.File {
+	t.Helper()
+	f, err := parser.ParseFile(fset, filename, src, 0)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return f
+}
+
+// TestKey provides unit test coverage for Key()
+func TestKey(t *testing.T) {
+	for _, s := range []string{"dark-red", "DARK_RED", "darkRed", "DarkRed", "dark red"} {
+		assert.Equal(t, "dark_red", Key(s), s)
+	}
+	for _, s := range []string{"utf8", "UTF8", "Utf8"} {
+		assert.Equal(t, "utf8", Key(s), s)
+	}
+}
+
+// TestOutputName provides unit test coverage for OutputName()
+func TestOutputName(t *testing.T) {
+	assert.Equal(t, "http_status_wordcase.go", OutputName("HTTPStatus"))
+}
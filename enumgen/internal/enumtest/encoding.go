@@ -0,0 +1,15 @@
+// Package enumtest has an enum type with code generated by wordcase-enum, to test the generated code
+package enumtest
+
+//go:generate go run ../../../cmd/wordcase-enum -type=Encoding -trimprefix=Encoding
+
+// Encoding is a character encoding
+type Encoding int
+
+// The encodings
+const (
+	EncodingASCII Encoding = iota
+	EncodingUtf8
+	EncodingUtf16LE
+	EncodingLatin1
+)
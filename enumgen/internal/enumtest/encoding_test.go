@@ -0,0 +1,45 @@
+package enumtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEncoding_UnmarshalText provides unit test coverage for the generated Encoding.UnmarshalText()
+func TestEncoding_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		text string
+		want Encoding
+	}{
+		{text: "utf8", want: EncodingUtf8},
+		{text: "UTF8", want: EncodingUtf8},
+		{text: "Utf8", want: EncodingUtf8},
+		{text: "UTF16_LE", want: EncodingUtf16LE},
+		{text: "utf16LE", want: EncodingUtf16LE},
+		{text: "ASCII", want: EncodingASCII},
+		{text: "LATIN1", want: EncodingLatin1},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.text, func(t *testing.T) {
+			t.Parallel()
+			var got Encoding
+			assert.NoError(t, got.UnmarshalText([]byte(tt.text)))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	var e Encoding
+	assert.EqualError(t, e.UnmarshalText([]byte("utf9")), `invalid Encoding "utf9"`)
+}
+
+// TestEncoding_MarshalText provides unit test coverage for the generated Encoding.MarshalText() and String()
+func TestEncoding_MarshalText(t *testing.T) {
+	got, err := EncodingUtf8.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "utf8", string(got))
+	assert.Equal(t, "utf16-le", EncodingUtf16LE.String())
+	assert.Equal(t, []Encoding{EncodingASCII, EncodingUtf8, EncodingUtf16LE, EncodingLatin1}, AllEncoding())
+}
@@ -0,0 +1,68 @@
+// Code generated by wordcase-enum -type=Encoding -trimprefix=Encoding; DO NOT EDIT.
+
+package enumtest
+
+import (
+	"fmt"
+
+	"github.com/mantidtech/wordcase"
+)
+
+// _EncodingText is the text of each Encoding value
+var _EncodingText = map[Encoding]string{
+	EncodingASCII:   "ascii",
+	EncodingUtf8:    "utf8",
+	EncodingUtf16LE: "utf16-le",
+	EncodingLatin1:  "latin1",
+}
+
+// _EncodingByKey finds a Encoding value by the canonical form of its text
+var _EncodingByKey = func() map[string]Encoding {
+	m := make(map[string]Encoding)
+	for text, val := range map[string]Encoding{
+		"ascii":    EncodingASCII,
+		"utf8":     EncodingUtf8,
+		"utf16-le": EncodingUtf16LE,
+		"latin1":   EncodingLatin1,
+	} {
+		m[wordcase.Key(text)] = val
+	}
+	return m
+}()
+
+// AllEncoding returns the values of Encoding, in the order they're declared
+func AllEncoding() []Encoding {
+	return []Encoding{
+		EncodingASCII,
+		EncodingUtf8,
+		EncodingUtf16LE,
+		EncodingLatin1,
+	}
+}
+
+// String returns the text of the value
+func (v Encoding) String() string {
+	if s, ok := _EncodingText[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("Encoding(%v)", int(v))
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (v Encoding) MarshalText() ([]byte, error) {
+	s, ok := _EncodingText[v]
+	if !ok {
+		return nil, fmt.Errorf("invalid Encoding %v", int(v))
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the text of a value written in any case
+func (v *Encoding) UnmarshalText(text []byte) error {
+	s, ok := _EncodingByKey[wordcase.Key(string(text))]
+	if !ok {
+		return fmt.Errorf("invalid Encoding %q", text)
+	}
+	*v = s
+	return nil
+}
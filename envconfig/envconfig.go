@@ -0,0 +1,322 @@
+// Package envconfig loads configuration structs from environment variables, with each variable's name derived from
+// the field's path through the struct, eg Database.MaxOpenConns -> APP_DATABASE_MAX_OPEN_CONNS
+package envconfig
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mantidtech/wordcase"
+	"github.com/mantidtech/wordcase/internal/fields"
+)
+
+// Environment is where variable values are read from
+type Environment struct {
+	Lookup func(name string) (string, bool) // returns the value of a variable, and whether it's set
+	Names  []string                         // the names of the variables that are set, used to report unknown variables
+}
+
+// OSEnvironment is the environment of the current process
+func OSEnvironment() Environment {
+	var names []string
+	for _, kv := range os.Environ() {
+		name, _, _ := strings.Cut(kv, "=")
+		names = append(names, name)
+	}
+	return Environment{Lookup: os.LookupEnv, Names: names}
+}
+
+// MapEnvironment is an environment with the variables in the map
+func MapEnvironment(m map[string]string) Environment {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return Environment{
+		Lookup: func(name string) (string, bool) {
+			v, ok := m[name]
+			return v, ok
+		},
+		Names: names,
+	}
+}
+
+// Options control how variable names are derived and where they're read from
+type Options struct {
+	Prefix    string            // added to the start of every name, eg "APP"
+	Separator string            // placed between the prefix and each level of nesting; default "_"
+	Style     wordcase.Combiner // converts each field name; default wordcase.ScreamingSnakeCase
+	Env       *Environment      // where the values are read from; default OSEnvironment()
+}
+
+// Var is an environment variable bound to a struct field
+type Var struct {
+	Name  string        // the variable name, eg "APP_DATABASE_MAX_OPEN_CONNS"
+	Path  string        // the path to the field, eg "Database.MaxOpenConns"
+	Type  reflect.Type  // the field's type
+	value reflect.Value // the field, when walking a value
+}
+
+// UnknownError reports a variable that has the prefix, or looks like a bound variable, but isn't bound to any field
+type UnknownError struct {
+	Name       string
+	Suggestion string // the bound variable with the same canonical tokens, if any, eg "APP_MAX_CONNS" for "app_maxConns"
+}
+
+// Error implements error
+func (e *UnknownError) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("unknown environment variable %s, did you mean %s?", e.Name, e.Suggestion)
+	}
+	return fmt.Sprintf("unknown environment variable %s", e.Name)
+}
+
+// ParseError reports a variable whose value can't be parsed into its field
+type ParseError struct {
+	Var   Var
+	Value string
+	Err   error
+}
+
+// Error implements error
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("environment variable %s (%s): invalid value %q: %v", e.Var.Name, e.Var.Path, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Vars lists the variables that would be bound to the fields of the struct (or pointer to a struct) dst
+func Vars(dst any, opts Options) ([]Var, error) {
+	v := reflect.ValueOf(dst)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct or pointer to a struct, got %T", dst)
+	}
+	w := walker{opts: opts.withDefaults(), guard: fields.NewGuard(v.Type())}
+	w.walk(reflect.New(v.Type()).Elem(), w.opts.Prefix, "") // walk a copy, so nil pointers to structs are left alone
+	return w.vars, nil
+}
+
+// Load sets the fields of the struct pointed to by dst from the environment.
+//
+//	Field names are converted with the style, and joined to their parents (and the prefix) with the separator.
+//	A field's `env` tag replaces its converted name, or excludes it when "-". Embedded structs add no level of nesting,
+//	and nil pointers to structs are allocated, except to a struct type that's already being walked (eg the Next field
+//	of a linked list node), which is skipped.
+//	Fields can be strings, bools, numbers, time.Duration, encoding.TextUnmarshaler, pointers to these,
+//	or slices of these given as comma separated values.
+//
+//	Variables that aren't bound to any field are reported as an *UnknownError if they have the prefix (when one is set),
+//	or have the same canonical tokens as a bound variable (eg "app_maxConns" for "APP_MAX_CONNS"). All errors are joined
+func Load(dst any, opts Options) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected a pointer to a struct, got %T", dst)
+	}
+	opts = opts.withDefaults()
+	w := walker{opts: opts, guard: fields.NewGuard(v.Elem().Type())}
+	w.walk(v.Elem(), opts.Prefix, "")
+
+	var errs []error
+	for _, vr := range w.vars {
+		s, ok := opts.Env.Lookup(vr.Name)
+		if !ok {
+			continue
+		}
+		if err := setValue(vr.value, s); err != nil {
+			var ne *strconv.NumError
+			if errors.As(err, &ne) {
+				err = ne.Err
+			}
+			errs = append(errs, &ParseError{Var: vr, Value: s, Err: err})
+		}
+	}
+	for _, u := range Unknown(w.vars, opts) {
+		errs = append(errs, u)
+	}
+	return errors.Join(errs...)
+}
+
+// Unknown finds the variables in the environment that aren't bound, but have the prefix or look like a bound variable
+func Unknown(vars []Var, opts Options) []*UnknownError {
+	opts = opts.withDefaults()
+	known := make(map[string]bool, len(vars))
+	byKey := make(map[string]string, len(vars))
+	for _, v := range vars {
+		known[v.Name] = true
+		byKey[canonical(v.Name)] = v.Name
+	}
+
+	var ret []*UnknownError
+	for _, name := range opts.Env.Names {
+		if known[name] {
+			continue
+		}
+		suggestion := byKey[canonical(name)]
+		if suggestion != "" || (opts.Prefix != "" && strings.HasPrefix(name, opts.Prefix+opts.Separator)) {
+			ret = append(ret, &UnknownError{Name: name, Suggestion: suggestion})
+		}
+	}
+	return ret
+}
+
+// canonical returns the lowercase tokens of a name, without separators, so names differing in case or
+// separators (eg "APP_MAX_CONNS", "app-max-conns", "appMaxConns") are the same
+func canonical(name string) string {
+	return strings.ToLower(strings.Join(wordcase.Tokenizer(name), ""))
+}
+
+// withDefaults returns the options with defaults in place of any unset
+func (o Options) withDefaults() Options {
+	if o.Separator == "" {
+		o.Separator = "_"
+	}
+	if o.Style == nil {
+		o.Style = wordcase.ScreamingSnakeCase
+	}
+	if o.Env == nil {
+		env := OSEnvironment()
+		o.Env = &env
+	}
+	return o
+}
+
+// walker collects the variables bound to the fields of a struct
+type walker struct {
+	opts  Options
+	vars  []Var
+	guard fields.Guard
+}
+
+// walk collects the variables for the fields of the struct v, with names starting with name
+func (w *walker) walk(v reflect.Value, name, path string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if fields.Skip(f) {
+			continue
+		}
+		tag := f.Tag.Get("env")
+		if tag == "-" {
+			continue
+		}
+
+		fv := v.Field(i)
+		ft := f.Type
+		fieldPath := fields.Join(path, ".", f.Name)
+		if fields.IsStruct(ft) {
+			if !w.guard.Enter(ft) {
+				continue // a recursive type, eg a linked list node
+			}
+			if ft.Kind() == reflect.Pointer && fv.CanSet() {
+				if fv.IsNil() {
+					fv.Set(reflect.New(ft.Elem()))
+				}
+				fv = fv.Elem()
+			} else if ft.Kind() == reflect.Pointer {
+				fv = reflect.New(ft.Elem()).Elem()
+			}
+			if f.Anonymous && tag == "" {
+				w.walk(fv, name, fieldPath)
+			} else {
+				w.walk(fv, fields.Join(name, w.opts.Separator, w.fieldName(f, tag)), fieldPath)
+			}
+			w.guard.Leave(ft)
+			continue
+		}
+		w.vars = append(w.vars, Var{
+			Name:  fields.Join(name, w.opts.Separator, w.fieldName(f, tag)),
+			Path:  fieldPath,
+			Type:  ft,
+			value: fv,
+		})
+	}
+}
+
+// fieldName returns the name of a field's level of nesting
+func (w *walker) fieldName(f reflect.StructField, tag string) string {
+	if tag != "" {
+		return tag
+	}
+	return w.opts.Style(f.Name)
+}
+
+// setValue parses s into v
+func setValue(v reflect.Value, s string) error {
+	if v.Kind() == reflect.Pointer {
+		p := reflect.New(v.Type().Elem())
+		if err := setValue(p.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(p)
+		return nil
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(fields.UnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Type() == fields.DurationType {
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return err
+			}
+			v.SetInt(int64(d))
+			return nil
+		}
+		n, err := strconv.ParseInt(s, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 0, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Slice:
+		var parts []string
+		if s != "" {
+			parts = strings.Split(s, ",")
+		}
+		sl := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i, p := range parts {
+			if err := setValue(sl.Index(i), strings.TrimSpace(p)); err != nil {
+				return err
+			}
+		}
+		v.Set(sl)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
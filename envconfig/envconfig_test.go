@@ -0,0 +1,213 @@
+package envconfig
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mantidtech/wordcase"
+)
+
+type testDatabase struct {
+	URL          string
+	MaxOpenConns int
+	Timeout      time.Duration
+}
+
+type testCommon struct {
+	Debug bool
+}
+
+type testConfig struct {
+	testCommon
+	Name     string
+	Database testDatabase
+	Replica  *testDatabase
+	Hosts    []string
+	Ports    []uint16
+	Ratio    float64
+	Limit    *int
+	Bind     net.IP
+	Secret   string `env:"API_KEY"`
+	Ignored  string `env:"-"`
+	hidden   string
+}
+
+// TestVars provides unit test coverage for Vars()
+func TestVars(t *testing.T) {
+	tests := []struct {
+		name string
+		dst  any
+		opts Options
+		want []string
+	}{
+		{
+			name: "defaults",
+			dst:  testConfig{},
+			want: []string{
+				"DEBUG", "NAME",
+				"DATABASE_URL", "DATABASE_MAX_OPEN_CONNS", "DATABASE_TIMEOUT",
+				"REPLICA_URL", "REPLICA_MAX_OPEN_CONNS", "REPLICA_TIMEOUT",
+				"HOSTS", "PORTS", "RATIO", "LIMIT", "BIND", "API_KEY",
+			},
+		},
+		{
+			name: "prefix and separator",
+			dst:  &testDatabase{},
+			opts: Options{Prefix: "APP", Separator: "__"},
+			want: []string{"APP__URL", "APP__MAX_OPEN_CONNS", "APP__TIMEOUT"},
+		},
+		{
+			name: "style",
+			dst:  testDatabase{},
+			opts: Options{Style: wordcase.KebabCase},
+			want: []string{"url", "max-open-conns", "timeout"},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Vars(tt.dst, tt.opts)
+			assert.NoError(t, err)
+			var names []string
+			for _, v := range got {
+				names = append(names, v.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+
+	vars, err := Vars(&testConfig{}, Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Database.MaxOpenConns", vars[3].Path)
+	assert.Equal(t, "testCommon.Debug", vars[0].Path)
+
+	_, err = Vars(3, Options{})
+	assert.Error(t, err)
+}
+
+// TestLoad provides unit test coverage for Load()
+func TestLoad(t *testing.T) {
+	env := MapEnvironment(map[string]string{
+		"APP_DEBUG":                   "true",
+		"APP_NAME":                    "svc",
+		"APP_DATABASE_URL":            "postgres://db",
+		"APP_DATABASE_MAX_OPEN_CONNS": "0x10",
+		"APP_DATABASE_TIMEOUT":        "5s",
+		"APP_REPLICA_URL":             "postgres://replica",
+		"APP_HOSTS":                   "a, b",
+		"APP_PORTS":                   "80,443",
+		"APP_RATIO":                   "0.5",
+		"APP_LIMIT":                   "7",
+		"APP_BIND":                    "127.0.0.1",
+		"APP_API_KEY":                 "secret",
+		"APP_IGNORED":                 "x",
+		"PATH":                        "/bin",
+	})
+
+	var got testConfig
+	err := Load(&got, Options{Prefix: "APP", Env: &env})
+	limit := 7
+	assert.Equal(t, testConfig{
+		testCommon: testCommon{Debug: true},
+		Name:       "svc",
+		Database:   testDatabase{URL: "postgres://db", MaxOpenConns: 16, Timeout: 5 * time.Second},
+		Replica:    &testDatabase{URL: "postgres://replica"},
+		Hosts:      []string{"a", "b"},
+		Ports:      []uint16{80, 443},
+		Ratio:      0.5,
+		Limit:      &limit,
+		Bind:       net.ParseIP("127.0.0.1"),
+		Secret:     "secret",
+	}, got)
+
+	var unknown *UnknownError
+	if assert.ErrorAs(t, err, &unknown) {
+		assert.Equal(t, "APP_IGNORED", unknown.Name)
+		assert.Equal(t, "", unknown.Suggestion)
+	}
+	assert.EqualError(t, err, "unknown environment variable APP_IGNORED")
+}
+
+// TestLoadErrors provides unit test coverage for the errors from Load()
+func TestLoadErrors(t *testing.T) {
+	env := MapEnvironment(map[string]string{
+		"DATABASE_MAX_OPEN_CONNS": "many",
+		"DATABASE_TIMEOUT":        "soon",
+		"PORTS":                   "80,99999",
+		"BIND":                    "nowhere",
+		"database_url":            "postgres://db",
+		"DatabaseURL":             "postgres://db",
+		"HOME":                    "/root",
+	})
+
+	var got testConfig
+	err := Load(&got, Options{Env: &env})
+	assert.EqualError(t, err, `environment variable DATABASE_MAX_OPEN_CONNS (Database.MaxOpenConns): invalid value "many": invalid syntax
+environment variable DATABASE_TIMEOUT (Database.Timeout): invalid value "soon": time: invalid duration "soon"
+environment variable PORTS (Ports): invalid value "80,99999": value out of range
+environment variable BIND (Bind): invalid value "nowhere": invalid IP address: nowhere
+unknown environment variable DatabaseURL, did you mean DATABASE_URL?
+unknown environment variable database_url, did you mean DATABASE_URL?`)
+
+	var pe *ParseError
+	if assert.ErrorAs(t, err, &pe) {
+		assert.Equal(t, "Database.MaxOpenConns", pe.Var.Path)
+		assert.True(t, errors.Is(err, strconv.ErrSyntax))
+	}
+
+	assert.Error(t, Load(got, Options{Env: &env}))
+	assert.Error(t, Load((*testConfig)(nil), Options{Env: &env}))
+
+	type unsupported struct{ Ch chan int }
+	env = MapEnvironment(map[string]string{"CH": "x"})
+	assert.EqualError(t, Load(&unsupported{}, Options{Env: &env}), `environment variable CH (Ch): invalid value "x": unsupported type chan int`)
+}
+
+// TestLoad_recursive checks fields of a struct type that's already being walked are skipped, rather than walked forever
+func TestLoad_recursive(t *testing.T) {
+	type node struct {
+		Name  string
+		Next  *node
+		Child struct {
+			Name   string
+			Parent *node
+		}
+	}
+
+	vars, err := Vars(&node{}, Options{})
+	assert.NoError(t, err)
+	var names []string
+	for _, v := range vars {
+		names = append(names, v.Name)
+	}
+	assert.Equal(t, []string{"NAME", "CHILD_NAME"}, names)
+
+	env := MapEnvironment(map[string]string{"NAME": "root", "CHILD_NAME": "leaf"})
+	var got node
+	assert.NoError(t, Load(&got, Options{Env: &env}))
+	assert.Equal(t, "root", got.Name)
+	assert.Equal(t, "leaf", got.Child.Name)
+	assert.Nil(t, got.Next)
+	assert.Nil(t, got.Child.Parent)
+}
+
+// TestOSEnvironment provides unit test coverage for OSEnvironment()
+func TestOSEnvironment(t *testing.T) {
+	t.Setenv("WORDCASE_TEST_NAME", "svc")
+	t.Setenv("WORDCASE_TEST_NAEM", "typo")
+
+	var got struct{ Name string }
+	err := Load(&got, Options{Prefix: "WORDCASE_TEST"})
+	assert.Equal(t, "svc", got.Name)
+	assert.EqualError(t, err, "unknown environment variable WORDCASE_TEST_NAEM")
+
+	env := OSEnvironment()
+	assert.Contains(t, env.Names, "WORDCASE_TEST_NAME")
+}
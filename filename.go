@@ -0,0 +1,128 @@
+package wordcase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// WindowsReservedNames are the device names Windows won't allow as a file name, with or without an extension
+var WindowsReservedNames = []string{
+	"CON", "PRN", "AUX", "NUL",
+	"COM1", "COM2", "COM3", "COM4", "COM5", "COM6", "COM7", "COM8", "COM9",
+	"LPT1", "LPT2", "LPT3", "LPT4", "LPT5", "LPT6", "LPT7", "LPT8", "LPT9",
+}
+
+// FilenameRules describe how a portable file name is made from a title
+type FilenameRules struct {
+	Stem      Combiner // converts the stem (the name without its extension); default KebabCase
+	MaxLength int      // the maximum length of the name in bytes, including the extension; default 255
+	ASCII     bool     // transliterate the stem to ASCII, dropping anything else (see ASCIIFold)
+	Fallback  string   // the stem used when the title leaves nothing; default "untitled"
+}
+
+// The default rules for file names
+const (
+	defaultFilenameMaxLength = 255
+	defaultFilenameFallback  = "untitled"
+	maxExtensionLength       = 16
+)
+
+// reservedFilenames are the Windows reserved names, lowercase
+var reservedFilenames = NewWordSet(Tokens(WindowsReservedNames).FormatAll(strings.ToLower)...)
+
+// Filename converts a title into a file name that's safe on Linux, macOS and Windows, with a kebab case stem and the
+// extension kept, eg "Q3 Results (draft).PDF" -> "q3-results-draft.PDF", "con.txt" -> "con_.txt"
+var Filename = FilenameRules{}.Style()
+
+// SnakeFilename is Filename with a snake case stem, eg "Q3 Results.txt" -> "q3_results.txt"
+var SnakeFilename = FilenameRules{Stem: SnakeCase}.Style()
+
+// KebabFilename is Filename with a kebab case stem, eg "Q3 Results.txt" -> "q3-results.txt"
+var KebabFilename = FilenameRules{Stem: KebabCase}.Style()
+
+// PascalFilename is Filename with a pascal case stem, eg "Q3 Results.txt" -> "Q3Results.txt"
+var PascalFilename = FilenameRules{Stem: PascalCase}.Style()
+
+// Style returns a Combiner that converts a title into a file name following the rules.
+//
+//	The extension is the text after the last ".", when it's only letters and digits and not too long.
+//	The stem has characters Windows doesn't allow, control characters, and leading and trailing dots and spaces
+//	removed, is shortened to fit the maximum length, and has "_" appended if it's a reserved name (or its last byte
+//	dropped instead, if there's no room for the "_")
+func (r FilenameRules) Style() Combiner {
+	style := r.Stem
+	if style == nil {
+		style = KebabCase
+	}
+	return func(s string) string {
+		stem, ext := splitExtension(strings.Trim(s, ". \t\r\n"))
+		if r.ASCII {
+			stem = ASCIIFold(stem)
+		}
+		stem = trimFilename(dropRunes(isNotFilenameRune)(style(stem)))
+
+		maxLength := r.MaxLength
+		if maxLength <= 0 {
+			maxLength = defaultFilenameMaxLength
+		}
+		if len(ext) >= maxLength {
+			ext = ""
+		}
+		room := maxLength - len(ext)
+		stem = trimFilename(truncateBytes(stem, room))
+
+		if stem == "" {
+			stem = r.Fallback
+			if stem == "" {
+				stem = defaultFilenameFallback
+			}
+			stem = truncateBytes(stem, room)
+		}
+		if reservedFilenames.Has(strings.ToLower(stem)) {
+			if len(stem) < room {
+				stem += "_"
+			} else {
+				stem = stem[:room-1] // too long for the "_", but no shorter name is reserved, eg "com1" -> "com"
+			}
+		}
+		return stem + ext
+	}
+}
+
+// splitExtension splits a name into its stem and extension (including the "."), if it has one
+func splitExtension(s string) (string, string) {
+	i := strings.LastIndexByte(s, '.')
+	if i <= 0 || len(s)-i-1 > maxExtensionLength || i == len(s)-1 {
+		return s, ""
+	}
+	for _, r := range s[i+1:] {
+		if r > unicode.MaxASCII || !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return s, ""
+		}
+	}
+	return s[:i], s[i:]
+}
+
+// isNotFilenameRune returns true for runes that aren't allowed in file names on some systems
+func isNotFilenameRune(r rune) bool {
+	return unicode.IsControl(r) || strings.ContainsRune(`<>:"/\|?*`, r) || r == utf8.RuneError
+}
+
+// trimFilename removes the leading and trailing dots, spaces and separators from a stem
+func trimFilename(s string) string {
+	return strings.TrimFunc(s, func(r rune) bool {
+		return r == '.' || r == '-' || r == '_' || unicode.IsSpace(r)
+	})
+}
+
+// truncateBytes cuts the string to at most n bytes, without splitting a rune
+func truncateBytes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,81 @@
+package wordcase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFilename provides unit test coverage for Filename()
+func TestFilename(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "empty", s: "", want: "untitled"},
+		{name: "title", s: "Q3 Results (draft)", want: "q3-results-draft"},
+		{name: "extension kept", s: "Q3 Results (draft).PDF", want: "q3-results-draft.PDF"},
+		{name: "windows characters", s: `What? A/B: "test" <1>|*.txt`, want: "what-a-b-test-1.txt"},
+		{name: "reserved", s: "CON", want: "con_"},
+		{name: "reserved with extension", s: "nul.txt", want: "nul_.txt"},
+		{name: "reserved prefix allowed", s: "console.txt", want: "console.txt"},
+		{name: "trailing dots and spaces", s: "report. . ", want: "report"},
+		{name: "leading dots", s: "..hidden", want: "hidden"},
+		{name: "dots only", s: "..", want: "untitled"},
+		{name: "extension only", s: ".txt", want: "txt"},
+		{name: "not an extension", s: "version 1.2 beta", want: "version-1-2-beta"},
+		{name: "long extension", s: "notes.averyveryverylongextension", want: "notes-averyveryverylongextension"},
+		{name: "control characters", s: "a\x00b\tc", want: "a-b-c"},
+		{name: "unicode kept", s: "Crème brûlée.md", want: "crème-brûlée.md"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, Filename(tt.s))
+		})
+	}
+}
+
+// TestFilenameStyles provides unit test coverage for SnakeFilename(), KebabFilename() and PascalFilename()
+func TestFilenameStyles(t *testing.T) {
+	assert.Equal(t, "q3_results.txt", SnakeFilename("Q3 Results.txt"))
+	assert.Equal(t, "q3-results.txt", KebabFilename("Q3 Results.txt"))
+	assert.Equal(t, "Q3Results.txt", PascalFilename("Q3 Results.txt"))
+	assert.Equal(t, "Con_.txt", PascalFilename("con.txt"))
+}
+
+// TestFilenameRules_Style provides unit test coverage for FilenameRules.Style()
+func TestFilenameRules_Style(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules FilenameRules
+		s     string
+		want  string
+	}{
+		{name: "max length", rules: FilenameRules{MaxLength: 12}, s: "Quarterly Results.pdf", want: "quarterl.pdf"},
+		{name: "max length trims separators", rules: FilenameRules{MaxLength: 14}, s: "Quarterly Results.pdf", want: "quarterly.pdf"},
+		{name: "max length runes", rules: FilenameRules{MaxLength: 8}, s: "ééééé.md", want: "éé.md"},
+		{name: "extension too long for limit", rules: FilenameRules{MaxLength: 4}, s: "report.markdown", want: "repo"},
+		{name: "reserved at limit", rules: FilenameRules{MaxLength: 7}, s: "con.txt", want: "co.txt"},
+		{name: "reserved under limit", rules: FilenameRules{MaxLength: 8}, s: "con.txt", want: "con_.txt"},
+		{name: "reserved after truncating", rules: FilenameRules{MaxLength: 4}, s: "com1 port", want: "com"},
+		{name: "fallback truncated", rules: FilenameRules{MaxLength: 6}, s: "???.txt", want: "un.txt"},
+		{name: "default max length", rules: FilenameRules{}, s: strings.Repeat("a", 300) + ".txt", want: strings.Repeat("a", 251) + ".txt"},
+		{name: "ascii", rules: FilenameRules{ASCII: true}, s: "Ærø Straße 日本.txt", want: "aero-strasse.txt"},
+		{name: "fallback", rules: FilenameRules{Fallback: "file"}, s: "???.txt", want: "file.txt"},
+		{name: "words style", rules: FilenameRules{Stem: Words}, s: "my report.txt", want: "my report.txt"},
+		{name: "windows characters from style", rules: FilenameRules{Stem: func(s string) string { return s }}, s: "a:b?.txt", want: "ab.txt"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.rules.Style()(tt.s))
+		})
+	}
+}
@@ -0,0 +1,266 @@
+// Package filerename plans and applies bulk renames of the files (and optionally directories) in a tree, converting
+// their base names to a wordcase style, eg "MyComponent.tsx" -> "my-component.tsx".
+//
+// Renames that would give two entries the same name, or the name of an entry that isn't being renamed, are reported
+// as collisions and left out. Names are compared ignoring case, so a plan is safe on case-insensitive file systems.
+package filerename
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mantidtech/wordcase"
+)
+
+// Options control which entries are renamed, and how
+type Options struct {
+	Style          wordcase.Combiner // converts the base name, without its extension
+	LowerExtension bool              // also convert the extension to lowercase, eg ".JPG" -> ".jpg"
+	Dirs           bool              // also rename directories
+	Include        []string          // only rename entries matching one of these globs, if any are given
+	Exclude        []string          // skip entries matching any of these globs, and don't walk into such directories
+}
+
+// Rename is a change of name for a file or directory
+type Rename struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Collision is a rename left out of a plan because its new name is already taken
+type Collision struct {
+	Rename
+	With string // the entry that has, or would have, the name
+}
+
+// Error describes the collision
+func (c Collision) Error() string {
+	return fmt.Sprintf("not renaming %s to %s: collides with %s", c.From, filepath.Base(c.To), c.With)
+}
+
+// Plan walks the tree under root, returning the renames that convert each name to the style, in the order they
+// should be applied (the contents of a directory before the directory), and the renames left out because of collisions.
+//
+//	Hidden entries (whose names start with ".") are skipped.
+//	Globs are matched (with path.Match) against both the base name and the slash separated path relative to root
+func Plan(root string, opts Options) ([]Rename, []Collision, error) {
+	if opts.Style == nil {
+		return nil, nil, errors.New("no style given")
+	}
+	for _, g := range append(append([]string{}, opts.Include...), opts.Exclude...) {
+		if _, err := path.Match(g, ""); err != nil {
+			return nil, nil, fmt.Errorf("bad glob %q: %w", g, err)
+		}
+	}
+
+	var renames []Rename
+	var collisions []Collision
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if p != root && (strings.HasPrefix(d.Name(), ".") || opts.excluded(root, p)) {
+			return fs.SkipDir
+		}
+		r, c, err := planDir(root, p, opts)
+		renames = append(renames, r...)
+		collisions = append(collisions, c...)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// deepest first, so nothing is renamed after the directory holding it
+	sort.SliceStable(renames, func(i, j int) bool {
+		return depth(renames[i].From) > depth(renames[j].From)
+	})
+	return renames, collisions, nil
+}
+
+// entry is a name in a directory, and what it will be called after renaming
+type entry struct {
+	name, newName string
+}
+
+// planDir plans the renames of the entries in a directory
+func planDir(root, dir string, opts Options) ([]Rename, []Collision, error) {
+	items, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entries := make([]entry, 0, len(items))
+	for _, it := range items {
+		e := entry{name: it.Name(), newName: it.Name()}
+		p := filepath.Join(dir, e.name)
+		if !strings.HasPrefix(e.name, ".") && (opts.Dirs || !it.IsDir()) && opts.included(root, p) && !opts.excluded(root, p) {
+			e.newName = opts.convert(e.name, it.IsDir())
+		}
+		entries = append(entries, e)
+	}
+
+	var collisions []Collision
+	for changed := true; changed; {
+		changed = false
+		holders := map[string]int{}
+		for i, e := range entries {
+			key := strings.ToLower(e.newName)
+			if j, ok := holders[key]; ok {
+				for _, k := range []int{j, i} {
+					if entries[k].name != entries[k].newName {
+						other := entries[j+i-k]
+						collisions = append(collisions, Collision{
+							Rename: Rename{From: filepath.Join(dir, entries[k].name), To: filepath.Join(dir, entries[k].newName)},
+							With:   filepath.Join(dir, other.name),
+						})
+						entries[k].newName = entries[k].name
+						changed = true
+					}
+				}
+				continue
+			}
+			holders[key] = i
+		}
+	}
+
+	var renames []Rename
+	for _, e := range entries {
+		if e.name != e.newName {
+			renames = append(renames, Rename{From: filepath.Join(dir, e.name), To: filepath.Join(dir, e.newName)})
+		}
+	}
+	return renames, collisions, nil
+}
+
+// convert returns the new name for an entry, keeping the extension of files
+func (o Options) convert(name string, dir bool) string {
+	stem, ext := name, ""
+	if !dir {
+		ext = filepath.Ext(name)
+		stem = strings.TrimSuffix(name, ext)
+	}
+	if o.LowerExtension {
+		ext = strings.ToLower(ext)
+	}
+	if newStem := o.Style(stem); newStem != "" {
+		stem = newStem
+	}
+	return stem + ext
+}
+
+// included returns true if there are no include globs, or the path matches one
+func (o Options) included(root, p string) bool {
+	return len(o.Include) == 0 || matchAny(o.Include, root, p)
+}
+
+// excluded returns true if the path matches an exclude glob
+func (o Options) excluded(root, p string) bool {
+	return matchAny(o.Exclude, root, p)
+}
+
+// matchAny returns true if the base name, or path relative to root, matches one of the globs
+func matchAny(globs []string, root, p string) bool {
+	rel, err := filepath.Rel(root, p)
+	if err != nil {
+		rel = p
+	}
+	rel = filepath.ToSlash(rel)
+	base := filepath.Base(p)
+	for _, g := range globs {
+		if ok, _ := path.Match(g, base); ok {
+			return true
+		}
+		if ok, _ := path.Match(g, rel); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// depth returns the number of path separators in the path
+func depth(p string) int {
+	return strings.Count(filepath.ToSlash(p), "/")
+}
+
+// Apply performs the renames in order, stopping at the first that fails, and returns the renames that were done.
+//
+//	A rename is refused if something other than the entry itself already has the new name.
+//	Renames that only change case go through a temporary name, for case-insensitive file systems
+func Apply(renames []Rename) ([]Rename, error) {
+	done := make([]Rename, 0, len(renames))
+	for _, r := range renames {
+		if err := rename(r.From, r.To); err != nil {
+			return done, err
+		}
+		done = append(done, r)
+	}
+	return done, nil
+}
+
+// rename renames a single entry
+func rename(from, to string) error {
+	src, err := os.Lstat(from)
+	if err != nil {
+		return err
+	}
+	if dst, err := os.Lstat(to); err == nil && !os.SameFile(src, dst) {
+		return fmt.Errorf("rename %s: %s already exists", from, to)
+	}
+	if !strings.EqualFold(from, to) {
+		return os.Rename(from, to)
+	}
+
+	tmp := to + ".wordcase-rename"
+	if _, err := os.Lstat(tmp); err == nil {
+		return fmt.Errorf("rename %s: %s already exists", from, tmp)
+	}
+	if err := os.Rename(from, tmp); err != nil {
+		return err
+	}
+	return os.Rename(tmp, to)
+}
+
+// Reverse returns the renames that undo the given ones
+func Reverse(renames []Rename) []Rename {
+	ret := make([]Rename, len(renames))
+	for i, r := range renames {
+		ret[len(renames)-1-i] = Rename{From: r.To, To: r.From}
+	}
+	return ret
+}
+
+// Manifest is a record of renames that were applied, so they can be undone
+type Manifest struct {
+	Renames []Rename `json:"renames"`
+}
+
+// WriteManifest writes the renames as a JSON manifest
+func WriteManifest(w io.Writer, renames []Rename) error {
+	if renames == nil {
+		renames = []Rename{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(Manifest{Renames: renames})
+}
+
+// ReadManifest reads a JSON manifest, returning the renames needed to undo it
+func ReadManifest(r io.Reader) ([]Rename, error) {
+	var m Manifest
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+	return Reverse(m.Renames), nil
+}
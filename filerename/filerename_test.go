@@ -0,0 +1,227 @@
+package filerename
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mantidtech/wordcase"
+)
+
+// makeTree creates the files (and their directories) under a new temporary directory
+func makeTree(t *testing.T, files ...string) string {
+	t.Helper()
+	root := t.TempDir()
+	for _, f := range files {
+		p := filepath.Join(root, filepath.FromSlash(f))
+		if !assert.NoError(t, os.MkdirAll(filepath.Dir(p), 0o750)) {
+			t.FailNow()
+		}
+		if !assert.NoError(t, os.WriteFile(p, []byte(f), 0o600)) {
+			t.FailNow()
+		}
+	}
+	return root
+}
+
+// relRenames returns the renames with paths relative to root, using slashes
+func relRenames(root string, renames []Rename) []Rename {
+	ret := make([]Rename, 0, len(renames))
+	for _, r := range renames {
+		from, _ := filepath.Rel(root, r.From)
+		to, _ := filepath.Rel(root, r.To)
+		ret = append(ret, Rename{From: filepath.ToSlash(from), To: filepath.ToSlash(to)})
+	}
+	return ret
+}
+
+// listTree returns the slash separated paths of the files under root
+func listTree(t *testing.T, root string) []string {
+	t.Helper()
+	var ret []string
+	err := filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			rel, _ := filepath.Rel(root, p)
+			ret = append(ret, filepath.ToSlash(rel))
+		}
+		return err
+	})
+	assert.NoError(t, err)
+	sort.Strings(ret)
+	return ret
+}
+
+// TestPlan provides unit test coverage for Plan()
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name           string
+		files          []string
+		opts           Options
+		wantRenames    []Rename
+		wantCollisions []Rename
+	}{
+		{
+			name:  "files",
+			files: []string{"MyComponent.tsx", "already-kebab.go", "Sub Dir/Some Photo.JPG", ".hidden/BadName.txt"},
+			opts:  Options{Style: wordcase.KebabCase},
+			wantRenames: []Rename{
+				{From: "Sub Dir/Some Photo.JPG", To: "Sub Dir/some-photo.JPG"},
+				{From: "MyComponent.tsx", To: "my-component.tsx"},
+			},
+		},
+		{
+			name:  "directories and extensions",
+			files: []string{"Sub Dir/Some Photo.JPG"},
+			opts:  Options{Style: wordcase.SnakeCase, Dirs: true, LowerExtension: true},
+			wantRenames: []Rename{
+				{From: "Sub Dir/Some Photo.JPG", To: "Sub Dir/some_photo.jpg"},
+				{From: "Sub Dir", To: "sub_dir"},
+			},
+		},
+		{
+			name:  "include",
+			files: []string{"MyComponent.tsx", "MyStyles.css", "src/OtherThing.tsx"},
+			opts:  Options{Style: wordcase.KebabCase, Include: []string{"*.tsx"}},
+			wantRenames: []Rename{
+				{From: "src/OtherThing.tsx", To: "src/other-thing.tsx"},
+				{From: "MyComponent.tsx", To: "my-component.tsx"},
+			},
+		},
+		{
+			name:        "exclude",
+			files:       []string{"MyComponent.tsx", "node_modules/SomePackage.js", "src/Keep Me.txt"},
+			opts:        Options{Style: wordcase.KebabCase, Exclude: []string{"node_modules", "src/*.txt"}},
+			wantRenames: []Rename{{From: "MyComponent.tsx", To: "my-component.tsx"}},
+		},
+		{
+			name:  "collision between renames",
+			files: []string{"FooBar.txt", "foo_bar.txt", "Other.txt"},
+			opts:  Options{Style: wordcase.KebabCase},
+			wantRenames: []Rename{
+				{From: "Other.txt", To: "other.txt"},
+			},
+			wantCollisions: []Rename{
+				{From: "FooBar.txt", To: "foo-bar.txt"},
+				{From: "foo_bar.txt", To: "foo-bar.txt"},
+			},
+		},
+		{
+			name:           "collision with existing",
+			files:          []string{"Foo Bar.txt", "foo-bar.txt"},
+			opts:           Options{Style: wordcase.KebabCase},
+			wantCollisions: []Rename{{From: "Foo Bar.txt", To: "foo-bar.txt"}},
+		},
+		{
+			name:           "collision ignoring case",
+			files:          []string{"Foo Bar.txt", "FOO-BAR.txt"},
+			opts:           Options{Style: wordcase.KebabCase, Include: []string{"Foo*"}},
+			wantCollisions: []Rename{{From: "Foo Bar.txt", To: "foo-bar.txt"}},
+		},
+		{
+			name:        "case only rename",
+			files:       []string{"README.md"},
+			opts:        Options{Style: wordcase.SnakeCase},
+			wantRenames: []Rename{{From: "README.md", To: "readme.md"}},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			root := makeTree(t, tt.files...)
+			renames, collisions, err := Plan(root, tt.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRenames, nilIfEmpty(relRenames(root, renames)))
+
+			var gotCollisions []Rename
+			for _, c := range collisions {
+				gotCollisions = append(gotCollisions, c.Rename)
+			}
+			assert.ElementsMatch(t, tt.wantCollisions, relRenames(root, gotCollisions))
+		})
+	}
+}
+
+// nilIfEmpty returns nil for an empty list, to compare with unset expectations
+func nilIfEmpty(r []Rename) []Rename {
+	if len(r) == 0 {
+		return nil
+	}
+	return r
+}
+
+// TestPlan_errors provides unit test coverage for the errors from Plan()
+func TestPlan_errors(t *testing.T) {
+	_, _, err := Plan(t.TempDir(), Options{})
+	assert.EqualError(t, err, "no style given")
+
+	_, _, err = Plan(t.TempDir(), Options{Style: wordcase.KebabCase, Exclude: []string{"["}})
+	assert.EqualError(t, err, `bad glob "[": syntax error in pattern`)
+
+	_, _, err = Plan(filepath.Join(t.TempDir(), "missing"), Options{Style: wordcase.KebabCase})
+	assert.Error(t, err)
+}
+
+// TestCollision_Error provides unit test coverage for Collision.Error()
+func TestCollision_Error(t *testing.T) {
+	c := Collision{Rename: Rename{From: "a/Foo Bar.txt", To: "a/foo-bar.txt"}, With: "a/foo-bar.txt"}
+	assert.EqualError(t, c, "not renaming a/Foo Bar.txt to foo-bar.txt: collides with a/foo-bar.txt")
+}
+
+// TestApply provides unit test coverage for Apply() and Reverse()
+func TestApply(t *testing.T) {
+	root := makeTree(t, "Sub Dir/Some Photo.JPG", "README.md", "MyComponent.tsx")
+	renames, collisions, err := Plan(root, Options{Style: wordcase.SnakeCase, Dirs: true, LowerExtension: true})
+	assert.NoError(t, err)
+	assert.Empty(t, collisions)
+
+	done, err := Apply(renames)
+	assert.NoError(t, err)
+	assert.Equal(t, renames, done)
+	assert.Equal(t, []string{"my_component.tsx", "readme.md", "sub_dir/some_photo.jpg"}, listTree(t, root))
+
+	done, err = Apply(Reverse(done))
+	assert.NoError(t, err)
+	assert.Len(t, done, 4)
+	assert.Equal(t, []string{"MyComponent.tsx", "README.md", "Sub Dir/Some Photo.JPG"}, listTree(t, root))
+}
+
+// TestApply_refused provides unit test coverage for Apply() when the new name is taken
+func TestApply_refused(t *testing.T) {
+	root := makeTree(t, "a.txt", "b.txt", "c.txt")
+	done, err := Apply([]Rename{
+		{From: filepath.Join(root, "a.txt"), To: filepath.Join(root, "x.txt")},
+		{From: filepath.Join(root, "b.txt"), To: filepath.Join(root, "c.txt")},
+		{From: filepath.Join(root, "x.txt"), To: filepath.Join(root, "y.txt")},
+	})
+	assert.ErrorContains(t, err, "already exists")
+	assert.Len(t, done, 1)
+	assert.Equal(t, []string{"b.txt", "c.txt", "x.txt"}, listTree(t, root))
+
+	_, err = Apply([]Rename{{From: filepath.Join(root, "missing"), To: filepath.Join(root, "z")}})
+	assert.Error(t, err)
+}
+
+// TestManifest provides unit test coverage for WriteManifest() and ReadManifest()
+func TestManifest(t *testing.T) {
+	renames := []Rename{{From: "a/B", To: "a/b"}, {From: "a", To: "A"}}
+	var buf bytes.Buffer
+	assert.NoError(t, WriteManifest(&buf, renames))
+	assert.JSONEq(t, `{"renames":[{"from":"a/B","to":"a/b"},{"from":"a","to":"A"}]}`, buf.String())
+
+	undo, err := ReadManifest(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, []Rename{{From: "A", To: "a"}, {From: "a/b", To: "a/B"}}, undo)
+
+	buf.Reset()
+	assert.NoError(t, WriteManifest(&buf, nil))
+	assert.JSONEq(t, `{"renames":[]}`, buf.String())
+
+	_, err = ReadManifest(bytes.NewBufferString("not json"))
+	assert.ErrorContains(t, err, "reading manifest")
+}
@@ -1,6 +1,7 @@
 package wordcase
 
 import (
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
@@ -11,6 +12,21 @@ var empty = struct{}{}
 // WordSet is a collection of words
 type WordSet map[string]struct{}
 
+// NewWordSet creates a WordSet from the given words
+func NewWordSet(words ...string) WordSet {
+	ws := make(WordSet, len(words))
+	for _, w := range words {
+		ws[w] = empty
+	}
+	return ws
+}
+
+// Has returns true if the given word is in the set
+func (ws WordSet) Has(word string) bool {
+	_, ok := ws[word]
+	return ok
+}
+
 // GoLintKeywords are words that golint considers to be common initialisms that should always be same-cased
 // (this list is https://github.com/golang/lint/blob/master/lint.go#L740 @ 2020-05-17)
 var GoLintKeywords = []string{
@@ -75,3 +91,11 @@ func UppercaseFirst(s string) string {
 	r, n := utf8.DecodeRuneInString(s)
 	return string(unicode.ToUpper(r)) + s[n:]
 }
+
+// UppercasePlural converts all but a trailing "s" to uppercase, for plural initialisms, eg "ids" -> "IDs"
+func UppercasePlural(s string) string {
+	if stem, ok := strings.CutSuffix(strings.ToLower(s), "s"); ok {
+		return strings.ToUpper(stem) + "s"
+	}
+	return strings.ToUpper(s)
+}
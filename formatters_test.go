@@ -70,3 +70,62 @@ func TestUppercaseFirst(t *testing.T) {
 		})
 	}
 }
+
+// TestWordSet provides unit test coverage for NewWordSet() and WordSet.Has()
+func TestWordSet(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []string
+		word  string
+		want  bool
+	}{
+		{
+			name: "empty",
+			word: "one",
+			want: false,
+		},
+		{
+			name:  "present",
+			words: []string{"one", "two"},
+			word:  "two",
+			want:  true,
+		},
+		{
+			name:  "case sensitive",
+			words: []string{"one", "two"},
+			word:  "Two",
+			want:  false,
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewWordSet(tt.words...).Has(tt.word)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestUppercasePlural provides unit test coverage for UppercasePlural()
+func TestUppercasePlural(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "", want: ""},
+		{s: "ids", want: "IDs"},
+		{s: "URLS", want: "URLs"},
+		{s: "id", want: "ID"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			got := UppercasePlural(tt.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
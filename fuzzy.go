@@ -0,0 +1,183 @@
+package wordcase
+
+import "sort"
+
+// The costs of the token edits used by Similarity
+const (
+	tokenEditCost          = 1.0 // inserting or deleting a token
+	tokenTranspositionCost = 0.5 // swapping two adjacent tokens, eg "retries_max" for "max_retries"
+	tokenMergeCost         = 0.2 // one token spelling two, eg "username" for "user_name"
+	abbreviationCost       = 0.3 // a token abbreviating another, eg "cfg" for "config", "max" for "maximum"
+)
+
+// DefaultMinScore is the lowest Similarity a Matcher suggests by default
+const DefaultMinScore = 0.5
+
+// Similarity returns how alike two identifiers are, ignoring their case style, from 0 (nothing alike) to 1 (the same
+// canonical Key), eg "maxRetires" and "max_retries" are 0.93.
+//
+//	It's 1 less the token edit distance divided by the number of tokens in the longer identifier. The token edit
+//	distance is the cheapest way to turn one list of (case-folded) tokens into the other by inserting or deleting
+//	tokens, swapping adjacent tokens, merging two tokens into one, or replacing a token, where a replacement costs
+//	the typos between the tokens (as a fraction of the longer token) or less if one abbreviates the other
+func Similarity(a, b string) float64 {
+	ta, tb := keyTokens(a), keyTokens(b)
+	n := len(ta)
+	if len(tb) > n {
+		n = len(tb)
+	}
+	if n == 0 {
+		return 1
+	}
+	score := 1 - tokenDistance(ta, tb)/float64(n)
+	if score < 0 {
+		return 0
+	}
+	return score
+}
+
+// Suggestion is a candidate close to a name
+type Suggestion struct {
+	Name  string  // the candidate, as given to the Matcher
+	Score float64 // the Similarity of the candidate to the name
+}
+
+// Matcher suggests the candidates closest to a name, eg for "did you mean" messages about misspelt keys
+type Matcher struct {
+	MinScore   float64 // the lowest Similarity suggested; DefaultMinScore if 0, or every candidate if negative
+	candidates []string
+}
+
+// NewMatcher creates a Matcher for the candidates
+func NewMatcher(candidates ...string) *Matcher {
+	return &Matcher{candidates: candidates}
+}
+
+// Add includes more candidates
+func (m *Matcher) Add(candidates ...string) {
+	m.candidates = append(m.candidates, candidates...)
+}
+
+// Suggest returns up to n candidates (all of them if n <= 0) scoring at least MinScore against the name,
+// best first (and in the order they were added when the scores are equal).
+// Set MinScore negative to rank every candidate, as a score of 0 can't be asked for
+func (m *Matcher) Suggest(name string, n int) []Suggestion {
+	minScore := m.MinScore
+	if minScore == 0 {
+		minScore = DefaultMinScore
+	}
+
+	var ret []Suggestion
+	for _, c := range m.candidates {
+		if s := Similarity(name, c); s >= minScore {
+			ret = append(ret, Suggestion{Name: c, Score: s})
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Score > ret[j].Score
+	})
+	if n > 0 && len(ret) > n {
+		ret = ret[:n]
+	}
+	return ret
+}
+
+// Best returns the closest candidate to the name, if any score at least MinScore
+func (m *Matcher) Best(name string) (string, bool) {
+	s := m.Suggest(name, 1)
+	if len(s) == 0 {
+		return "", false
+	}
+	return s[0].Name, true
+}
+
+// tokenDistance returns the token edit distance between two lists of tokens (see Similarity)
+func tokenDistance(a, b Tokens) float64 {
+	d := make([][]float64, len(a)+1)
+	for i := range d {
+		d[i] = make([]float64, len(b)+1)
+		d[i][0] = float64(i) * tokenEditCost
+	}
+	for j := range d[0] {
+		d[0][j] = float64(j) * tokenEditCost
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			best := min(
+				d[i-1][j]+tokenEditCost,
+				d[i][j-1]+tokenEditCost,
+				d[i-1][j-1]+tokenCost(a[i-1], b[j-1]),
+			)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				best = min(best, d[i-2][j-2]+tokenTranspositionCost)
+			}
+			if j > 1 && a[i-1] == b[j-2]+b[j-1] {
+				best = min(best, d[i-1][j-2]+tokenMergeCost)
+			}
+			if i > 1 && b[j-1] == a[i-2]+a[i-1] {
+				best = min(best, d[i-2][j-1]+tokenMergeCost)
+			}
+			d[i][j] = best
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+// tokenCost returns the cost of replacing one token with another: 0 if they're the same, abbreviationCost if one
+// abbreviates the other, or the number of typos between them as a fraction of the longer, whichever is least
+func tokenCost(a, b string) float64 {
+	if a == b {
+		return 0
+	}
+	ra, rb := []rune(a), []rune(b)
+	n := len(ra)
+	if len(rb) > n {
+		n = len(rb)
+	}
+	cost := float64(runeDistance(ra, rb)) / float64(n)
+	if isAbbreviation(ra, rb) || isAbbreviation(rb, ra) {
+		cost = min(cost, abbreviationCost)
+	}
+	return cost
+}
+
+// isAbbreviation returns true if short is at least two runes, starts with the same rune as long, and its runes
+// appear in order in long, eg "cfg" for "config", "max" for "maximum"
+func isAbbreviation(short, long []rune) bool {
+	if len(short) < 2 || len(short) >= len(long) || short[0] != long[0] {
+		return false
+	}
+	i := 0
+	for _, r := range long {
+		if i < len(short) && short[i] == r {
+			i++
+		}
+	}
+	return i == len(short)
+}
+
+// runeDistance returns the number of typos (inserted, deleted, replaced or swapped adjacent runes) between a and b
+func runeDistance(a, b []rune) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
@@ -0,0 +1,95 @@
+package wordcase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSimilarity provides unit test coverage for Similarity()
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{name: "empty", a: "", b: "", want: 1},
+		{name: "same key", a: "maxRetries", b: "MAX_RETRIES", want: 1},
+		{name: "typo in a token", a: "maxRetires", b: "max_retries", want: 1 - (1.0/7)/2},
+		{name: "token transposition", a: "retries_max", b: "max_retries", want: 0.75},
+		{name: "abbreviation", a: "cfgPath", b: "config_path", want: 0.85},
+		{name: "prefix abbreviation", a: "max_conns", b: "maximum_connections", want: 0.7},
+		{name: "merged tokens", a: "username", b: "user_name", want: 0.9},
+		{name: "split tokens", a: "user_name", b: "username", want: 0.9},
+		{name: "missing token", a: "timeout", b: "read_timeout", want: 0.5},
+		{name: "unrelated", a: "colour", b: "max_retries", want: 0},
+		{name: "one empty", a: "", b: "abc", want: 0},
+		{name: "separated initialism", a: "CPU Is Busy", b: "cpu_is_busy", want: 1},
+		{name: "separated initialism merged", a: "CPU Is Busy", b: "cpuis_busy", want: 1 - 0.2/3},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.InDelta(t, tt.want, Similarity(tt.a, tt.b), 1e-9)
+			assert.InDelta(t, tt.want, Similarity(tt.b, tt.a), 1e-9, "similarity is symmetric")
+		})
+	}
+}
+
+// TestMatcher_Suggest provides unit test coverage for Matcher.Suggest()
+func TestMatcher_Suggest(t *testing.T) {
+	m := NewMatcher("max_retries", "min_retries", "retry_delay", "timeout", "read_timeout")
+	m.Add("user_name", "cpuis_busy", "cpu_is_busy")
+
+	tests := []struct {
+		name     string
+		minScore float64
+		s        string
+		n        int
+		want     []string
+	}{
+		{name: "typo", s: "maxRetires", n: 1, want: []string{"max_retries"}},
+		{name: "ranked", s: "maxRetires", n: 0, want: []string{"max_retries", "min_retries"}},
+		{name: "limited", s: "timeout", n: 1, want: []string{"timeout"}},
+		{name: "ties keep order", s: "timeout", n: 0, want: []string{"timeout", "read_timeout"}},
+		{name: "style ignored", s: "USERNAME", n: 0, want: []string{"user_name"}},
+		{name: "nothing close", s: "colour", n: 0, want: nil},
+		{name: "min score", minScore: 0.9, s: "maxRetires", n: 0, want: []string{"max_retries"}},
+		{name: "separated initialism", s: "CPU Is Busy", n: 0, want: []string{"cpu_is_busy", "cpuis_busy"}},
+		{
+			name: "negative min score ranks all", minScore: -1, s: "timeout", n: 0,
+			want: []string{"timeout", "read_timeout", "user_name", "max_retries", "min_retries", "retry_delay", "cpu_is_busy", "cpuis_busy"},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mm := *m
+			mm.MinScore = tt.minScore
+			var got []string
+			for _, s := range mm.Suggest(tt.s, tt.n) {
+				got = append(got, s.Name)
+				if tt.minScore >= 0 {
+					assert.GreaterOrEqual(t, s.Score, DefaultMinScore)
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestMatcher_Best provides unit test coverage for Matcher.Best()
+func TestMatcher_Best(t *testing.T) {
+	m := NewMatcher("max_retries", "retry_delay")
+	got, ok := m.Best("maxRetires")
+	assert.True(t, ok)
+	assert.Equal(t, "max_retries", got)
+
+	_, ok = m.Best("colour")
+	assert.False(t, ok)
+}
@@ -0,0 +1,117 @@
+package wordcase
+
+import (
+	"strings"
+	"unicode"
+)
+
+// goExportedRules are GoRules, but prefixing an "X" to identifiers starting with a digit so they stay exported
+var goExportedRules = IdentifierRules{
+	Reserved:    GoRules.Reserved,
+	Escape:      GoRules.Escape,
+	DigitPrefix: "X",
+	Allowed:     GoRules.Allowed,
+	Replacement: GoRules.Replacement,
+}
+
+// goPackageRules are the rules for Go package names: lowercase letters and digits only
+var goPackageRules = IdentifierRules{
+	Reserved:    GoRules.Reserved,
+	Escape:      func(s string) string { return s + "pkg" },
+	DigitPrefix: "pkg",
+	Allowed:     func(r rune) bool { return unicode.IsLower(r) || unicode.IsDigit(r) },
+	Replacement: "",
+}
+
+// goLintWords are the GoLintKeywords
+var goLintWords = NewWordSet(GoLintKeywords...)
+
+// goInitialisms matches tokens that are one of the GoLintKeywords, on their own or followed by digits, eg "id2",
+// as Tokenizer keeps them together
+var goInitialisms = Where(func(c TokenContext) bool {
+	l := strings.ToLower(c.Token())
+	return goLintWords.Has(l) || goLintWords.Has(strings.TrimRightFunc(l, unicode.IsDigit))
+})
+
+// goPascalCase is PascalCase for the tokens of the given pipeline
+func goPascalCase(p Pipeline) Combiner {
+	return p.
+		WithAllFormatter(strings.ToLower).
+		WithAllFormatter(UppercaseFirst).
+		WithFormatter(strings.ToUpper, goInitialisms).
+		JoinWith("")
+}
+
+// GoExported converts a string into an exported Go identifier, with initialisms from GoLintKeywords kept uppercase,
+// eg "serve http" -> "ServeHTTP", "2fa code" -> "X2faCode". Like all the Go helpers it breaks the string up with
+// Tokenizer, so an initialism followed by digits stays whole, eg "UTF8Decoder" -> "UTF8Decoder"
+var GoExported = goExportedRules.Apply(goPascalCase(Tokenizer))
+
+// GoUnexported converts a string into an unexported Go identifier, with initialisms from GoLintKeywords kept
+// same-cased, eg "user id" -> "userID", "XMLHttpRequest" -> "xmlHTTPRequest", "type" -> "type_"
+var GoUnexported = GoRules.Apply(
+	Tokenizer.
+		WithAllFormatter(strings.ToLower).
+		WithFormatter(UppercaseFirst, ToRest).
+		WithFormatter(strings.ToUpper, And(ToRest, goInitialisms)).
+		JoinWith(""),
+)
+
+// GoPackageName converts a string into a Go package name: lowercase, with no underscores or mixed caps,
+// eg "User_Service" -> "userservice". Keywords, or names starting with a digit, have "pkg" added
+var GoPackageName = goPackageRules.Apply(
+	Tokenizer.
+		WithAllFormatter(strings.ToLower).
+		JoinWith(""),
+)
+
+// goReceiverRules are goPackageRules, but using just the first letter of a keyword
+var goReceiverRules = IdentifierRules{
+	Reserved:    GoRules.Reserved,
+	Escape:      firstRune,
+	DigitPrefix: "x",
+	Allowed:     goPackageRules.Allowed,
+	Replacement: "",
+}
+
+// GoReceiverName converts a type name into a conventional receiver name: the lowercase first letter of each token,
+// eg "UserService" -> "us", "HTTPServer" -> "hs". If that's a keyword, only the first letter is used
+var GoReceiverName = goReceiverRules.Apply(
+	Tokenizer.
+		WithAllFormatter(strings.ToLower).
+		WithAllFormatter(firstRune).
+		JoinWith(""),
+)
+
+// firstRune returns the first rune of the given string
+func firstRune(s string) string {
+	for _, r := range s {
+		return string(r)
+	}
+	return ""
+}
+
+// Stutter returns a selector that matches the leading tokens that repeat the given package name, so long as there
+// are tokens left after them, eg for the package "http", matches "HTTP" in "HTTP", "Server"
+func Stutter(pkg string) TokenSelector {
+	pkg = strings.ToLower(pkg)
+	return func(t Tokens) []int {
+		var b strings.Builder
+		for i := 0; i < len(t)-1; i++ {
+			b.WriteString(strings.ToLower(t[i]))
+			if b.Len() >= len(pkg) {
+				if b.String() == pkg {
+					return ToRange(0, i+1)(t)
+				}
+				break
+			}
+		}
+		return nil
+	}
+}
+
+// GoExportedWithoutStutter returns a Combiner like GoExported, that also drops any leading words repeating the
+// package name the identifier will be used in, eg for the package "http", "http server" -> "Server"
+func GoExportedWithoutStutter(pkg string) Combiner {
+	return goExportedRules.Apply(goPascalCase(Tokenizer.Drop(Stutter(pkg))))
+}
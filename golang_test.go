@@ -0,0 +1,83 @@
+package wordcase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGoNames provides unit test coverage for the Go naming Combiners
+func TestGoNames(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   Combiner
+		s    string
+		want string
+	}{
+		{name: "exported", fn: GoExported, s: "serve http", want: "ServeHTTP"},
+		{name: "exported initialism first", fn: GoExported, s: "xml_http_request", want: "XMLHTTPRequest"},
+		{name: "exported keyword", fn: GoExported, s: "type", want: "Type"},
+		{name: "exported digit", fn: GoExported, s: "2fa code", want: "X2faCode"},
+		{name: "exported digit initialism", fn: GoExported, s: "UTF8Decoder", want: "UTF8Decoder"},
+		{name: "exported digit initialism words", fn: GoExported, s: "utf8 decoder", want: "UTF8Decoder"},
+		{name: "exported digits after initialism", fn: GoExported, s: "SHA256Sum", want: "Sha256Sum"},
+		{name: "exported mixed case initialism", fn: GoExported, s: "IPv4 address", want: "IPv4Address"},
+		{name: "exported initialism with digit suffix", fn: GoExported, s: "user_ID2", want: "UserID2"},
+		{name: "unexported", fn: GoUnexported, s: "User ID", want: "userID"},
+		{name: "unexported initialism first", fn: GoUnexported, s: "XMLHttpRequest", want: "xmlHTTPRequest"},
+		{name: "unexported keyword", fn: GoUnexported, s: "Type", want: "type_"},
+		{name: "unexported digit", fn: GoUnexported, s: "2fa code", want: "_2faCode"},
+		{name: "unexported digit initialism", fn: GoUnexported, s: "UTF8Decoder", want: "utf8Decoder"},
+		{name: "unexported digit initialism later", fn: GoUnexported, s: "decode_utf8", want: "decodeUTF8"},
+		{name: "unexported digits after initialism", fn: GoUnexported, s: "SHA256Sum", want: "sha256Sum"},
+		{name: "unexported mixed case initialism", fn: GoUnexported, s: "ipv4 address", want: "ipv4Address"},
+		{name: "unexported initialism with digit suffix", fn: GoUnexported, s: "user_id2", want: "userID2"},
+		{name: "package", fn: GoPackageName, s: "User_Service", want: "userservice"},
+		{name: "package non-ascii kept", fn: GoPackageName, s: "Über-Paket", want: "überpaket"},
+		{name: "package keyword", fn: GoPackageName, s: "Func", want: "funcpkg"},
+		{name: "package digit", fn: GoPackageName, s: "3d-models", want: "pkg3dmodels"},
+		{name: "receiver", fn: GoReceiverName, s: "UserService", want: "us"},
+		{name: "receiver initialism", fn: GoReceiverName, s: "HTTPServer", want: "hs"},
+		{name: "receiver single", fn: GoReceiverName, s: "Client", want: "c"},
+		{name: "receiver keyword", fn: GoReceiverName, s: "ImageFile", want: "i"},
+		{name: "stutter", fn: GoExportedWithoutStutter("http"), s: "HTTPServer", want: "Server"},
+		{name: "stutter multi token", fn: GoExportedWithoutStutter("userservice"), s: "user_service_client", want: "Client"},
+		{name: "stutter only token kept", fn: GoExportedWithoutStutter("http"), s: "http", want: "HTTP"},
+		{name: "no stutter", fn: GoExportedWithoutStutter("http"), s: "ServeHTTP", want: "ServeHTTP"},
+		{name: "no stutter agrees with exported", fn: GoExportedWithoutStutter(""), s: "UTF8Decoder", want: "UTF8Decoder"},
+		{name: "partial token isn't stutter", fn: GoExportedWithoutStutter("user"), s: "users_list", want: "UsersList"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.fn(tt.s))
+		})
+	}
+}
+
+// TestStutter provides unit test coverage for Stutter()
+func TestStutter(t *testing.T) {
+	tests := []struct {
+		name   string
+		pkg    string
+		tokens Tokens
+		want   []int
+	}{
+		{name: "empty", pkg: "http", tokens: Tokens{}, want: nil},
+		{name: "one token", pkg: "http", tokens: Tokens{"HTTP", "Server"}, want: []int{0}},
+		{name: "two tokens", pkg: "userservice", tokens: Tokens{"User", "Service", "Client"}, want: []int{0, 1}},
+		{name: "whole name", pkg: "userservice", tokens: Tokens{"User", "Service"}, want: nil},
+		{name: "overshoots", pkg: "use", tokens: Tokens{"User", "Service"}, want: nil},
+		{name: "not leading", pkg: "http", tokens: Tokens{"Serve", "HTTP"}, want: nil},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, Stutter(tt.pkg)(tt.tokens))
+		})
+	}
+}
@@ -0,0 +1,577 @@
+// Package gorename renames identifiers in a Go package so they follow a naming convention
+package gorename
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mantidtech/wordcase"
+)
+
+// Convention decides which identifiers need renaming, and what to
+type Convention struct {
+	Violates   func(name string) bool // returns true if the name doesn't follow the convention
+	Exported   wordcase.Combiner      // converts the name of an exported identifier
+	Unexported wordcase.Combiner      // converts the name of an unexported identifier
+}
+
+// MixedCaps is the Go convention of using MixedCaps rather than underscores, eg "user_id" -> "userID"
+var MixedCaps = Convention{
+	Violates:   func(name string) bool { return strings.Contains(strings.Trim(name, "_"), "_") },
+	Exported:   wordcase.GoExported,
+	Unexported: wordcase.GoUnexported,
+}
+
+// Lint is MixedCaps, but also requires initialisms from wordcase.GoLintKeywords to be same-cased, eg "userId" -> "userID"
+var Lint = Convention{
+	Violates: func(name string) bool {
+		if ast.IsExported(name) {
+			return wordcase.GoExported(name) != name
+		}
+		return wordcase.GoUnexported(name) != name
+	},
+	Exported:   wordcase.GoExported,
+	Unexported: wordcase.GoUnexported,
+}
+
+// Options control which identifiers may be renamed
+type Options struct {
+	Exported bool // allow renaming exported package members, fields and methods, which changes the package API
+}
+
+// Package is a parsed and type checked Go package, including its test files.
+// Files holds the files of the package and its in-package tests, then those of its external (package_test) tests
+type Package struct {
+	Dir   string
+	Fset  *token.FileSet
+	Files []*ast.File
+	Types *types.Package
+	Info  *types.Info
+
+	src      map[*ast.File][]byte
+	names    map[*ast.File]string
+	structOf map[*types.Var]*types.Struct
+	embedded map[*types.TypeName]bool
+}
+
+// Rename is a planned change of an identifier's name
+type Rename struct {
+	Object types.Object
+	From   string
+	To     string
+}
+
+// Refusal is an identifier that violates the convention, but can't be renamed safely
+type Refusal struct {
+	Object types.Object
+	From   string
+	To     string
+	Reason string
+}
+
+// Load parses and type checks the package in the given directory
+func Load(dir string) (*Package, error) {
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Package{
+		Dir:      dir,
+		Fset:     token.NewFileSet(),
+		src:      make(map[*ast.File][]byte),
+		names:    make(map[*ast.File]string),
+		structOf: make(map[*types.Var]*types.Struct),
+		embedded: make(map[*types.TypeName]bool),
+	}
+
+	for _, name := range append(append([]string{}, bp.GoFiles...), bp.TestGoFiles...) {
+		path := filepath.Join(dir, name)
+		f, err := parser.ParseFile(p.Fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		p.Files = append(p.Files, f)
+		p.names[f] = path
+	}
+	for _, f := range p.Files {
+		src, err := os.ReadFile(p.names[f])
+		if err != nil {
+			return nil, err
+		}
+		p.src[f] = src
+	}
+
+	p.Info = &types.Info{
+		Defs:   make(map[*ast.Ident]types.Object),
+		Uses:   make(map[*ast.Ident]types.Object),
+		Types:  make(map[ast.Expr]types.TypeAndValue),
+		Scopes: make(map[ast.Node]*types.Scope),
+	}
+	imp := importer.ForCompiler(p.Fset, "source", nil).(types.ImporterFrom)
+	conf := types.Config{Importer: imp}
+	p.Types, err = conf.Check(bp.ImportPath, p.Fset, p.Files, p.Info)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkXTests(dir, bp, imp); err != nil {
+		return nil, err
+	}
+
+	p.indexStructs()
+	return p, nil
+}
+
+// checkXTests parses and type checks the external test files against the package, adding them to its files and
+// info so the uses of renamed identifiers in them are updated too
+func (p *Package) checkXTests(dir string, bp *build.Package, imp types.ImporterFrom) error {
+	if len(bp.XTestGoFiles) == 0 {
+		return nil
+	}
+
+	var files []*ast.File
+	for _, name := range bp.XTestGoFiles {
+		path := filepath.Join(dir, name)
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		f, err := parser.ParseFile(p.Fset, path, src, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+		p.names[f] = path
+		p.src[f] = src
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	conf := types.Config{Importer: xtestImporter{pkg: p.Types, dir: abs, from: imp}}
+	if _, err := conf.Check(p.Types.Name()+"_test", p.Fset, files, p.Info); err != nil {
+		return err
+	}
+	p.Files = append(p.Files, files...)
+	return nil
+}
+
+// xtestImporter imports the package being renamed (the one in dir) as it was type checked, so its objects are the
+// ones the external tests use, and anything else with another importer
+type xtestImporter struct {
+	pkg  *types.Package
+	dir  string
+	from types.ImporterFrom
+}
+
+// Import implements types.Importer
+func (i xtestImporter) Import(path string) (*types.Package, error) {
+	return i.ImportFrom(path, i.dir, 0)
+}
+
+// ImportFrom implements types.ImporterFrom
+func (i xtestImporter) ImportFrom(path, dir string, mode types.ImportMode) (*types.Package, error) {
+	ctxt := build.Default
+	ctxt.Dir = i.dir // so the package's own module resolves its import path
+	if bp, err := ctxt.Import(path, dir, build.FindOnly); err == nil {
+		if abs, err := filepath.Abs(bp.Dir); err == nil && abs == i.dir {
+			return i.pkg, nil
+		}
+	}
+	return i.from.ImportFrom(path, dir, mode)
+}
+
+// indexStructs records the struct each field belongs to, and the types used as embedded fields
+func (p *Package) indexStructs() {
+	for _, f := range p.Files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			st, ok := n.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			s, ok := p.Info.Types[st].Type.(*types.Struct)
+			if !ok {
+				return true
+			}
+			for i := 0; i < s.NumFields(); i++ {
+				fld := s.Field(i)
+				p.structOf[fld] = s
+				if fld.Embedded() {
+					if n, ok := derefNamed(fld.Type()); ok {
+						p.embedded[n.Obj()] = true
+					}
+				}
+			}
+			return true
+		})
+	}
+}
+
+// derefNamed returns the named type of t, looking through a pointer
+func derefNamed(t types.Type) (*types.Named, bool) {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	n, ok := t.(*types.Named)
+	return n, ok
+}
+
+// Plan works out which identifiers violate the convention and what to rename them to.
+// Identifiers that can't be renamed without changing the meaning of the program are returned as refusals
+func (p *Package) Plan(conv Convention, opts Options) ([]Rename, []Refusal) {
+	r := newPlanner(p)
+
+	var renames []Rename
+	var refusals []Refusal
+	for _, obj := range p.candidates() {
+		from := obj.Name()
+		if !conv.Violates(from) {
+			continue
+		}
+		to := conv.Unexported(from)
+		if obj.Exported() {
+			to = conv.Exported(from)
+		}
+		if to == from {
+			continue
+		}
+		if reason := r.refuse(obj, to, opts); reason != "" {
+			refusals = append(refusals, Refusal{Object: obj, From: from, To: to, Reason: reason})
+			continue
+		}
+		r.rename(obj, to)
+		renames = append(renames, Rename{Object: obj, From: from, To: to})
+	}
+	return renames, refusals
+}
+
+// candidates returns the objects declared in the package that could be renamed, in source order
+func (p *Package) candidates() []types.Object {
+	var ret []types.Object
+	for id, obj := range p.Info.Defs {
+		if obj == nil || obj.Pkg() != p.Types || id.Name == "_" {
+			continue
+		}
+		switch o := obj.(type) {
+		case *types.Var:
+			if o.Embedded() {
+				continue
+			}
+		case *types.Const, *types.TypeName, *types.Func:
+		default:
+			continue
+		}
+		ret = append(ret, obj)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Pos() < ret[j].Pos()
+	})
+	return ret
+}
+
+// isAPI returns true if changing the name of the object changes the package API
+func (p *Package) isAPI(obj types.Object) bool {
+	if !obj.Exported() {
+		return false
+	}
+	if obj.Parent() == p.Types.Scope() {
+		return true
+	}
+	if v, ok := obj.(*types.Var); ok && v.IsField() {
+		return true
+	}
+	_, isFunc := obj.(*types.Func)
+	return isFunc
+}
+
+// isMethod returns true for methods, including interface methods
+func isMethod(obj types.Object) bool {
+	f, ok := obj.(*types.Func)
+	return ok && f.Type().(*types.Signature).Recv() != nil
+}
+
+// planner tracks the names objects will have as renames are accepted, to check the ones that follow
+type planner struct {
+	p       *Package
+	renamed map[types.Object]string
+	uses    map[types.Object][]*ast.Ident
+}
+
+// newPlanner creates a planner for the package
+func newPlanner(p *Package) *planner {
+	r := &planner{
+		p:       p,
+		renamed: make(map[types.Object]string),
+		uses:    make(map[types.Object][]*ast.Ident),
+	}
+	for id, obj := range p.Info.Uses {
+		r.uses[obj] = append(r.uses[obj], id)
+	}
+	return r
+}
+
+// name returns the name the object will have once the accepted renames are applied
+func (r *planner) name(obj types.Object) string {
+	if n, ok := r.renamed[obj]; ok {
+		return n
+	}
+	return obj.Name()
+}
+
+// rename accepts a rename
+func (r *planner) rename(obj types.Object, to string) {
+	r.renamed[obj] = to
+}
+
+// lookup finds an object declared directly in the scope that will have the given name
+func (r *planner) lookup(s *types.Scope, name string) types.Object {
+	for _, n := range s.Names() {
+		if o := s.Lookup(n); r.name(o) == name {
+			return o
+		}
+	}
+	return nil
+}
+
+// refuse returns the reason renaming obj to the given name isn't safe, or an empty string if it is
+func (r *planner) refuse(obj types.Object, to string, opts Options) string {
+	switch {
+	case !token.IsIdentifier(to):
+		return fmt.Sprintf("%q isn't a valid identifier", to)
+	case ast.IsExported(to) != obj.Exported():
+		return fmt.Sprintf("%q would change whether it's exported", to)
+	case r.p.isAPI(obj) && !opts.Exported:
+		return "renaming it would change the package API"
+	case isMethod(obj):
+		return "methods may be needed to satisfy interfaces"
+	case obj.Parent() == r.p.Types.Scope() && (obj.Name() == "init" || obj.Name() == "main"):
+		return "it has a special meaning"
+	}
+	if tn, ok := obj.(*types.TypeName); ok && r.p.embedded[tn] {
+		return "it's used as an embedded field"
+	}
+	if v, ok := obj.(*types.Var); ok && v.IsField() {
+		return r.refuseField(v, to)
+	}
+	return r.refuseScoped(obj, to)
+}
+
+// refuseField checks a field can be renamed without colliding with another field or method
+func (r *planner) refuseField(v *types.Var, to string) string {
+	s := r.p.structOf[v]
+	if s == nil {
+		return "the struct it belongs to couldn't be found"
+	}
+	for i := 0; i < s.NumFields(); i++ {
+		if f := s.Field(i); f != v && r.name(f) == to {
+			return fmt.Sprintf("it would collide with the field declared at %s", r.p.Fset.Position(f.Pos()))
+		}
+	}
+	// the field is promoted to the structs that embed it, so check the name is free in those too
+	for _, t := range r.p.structTypes() {
+		if t.Underlying() != s && !embeds(t, s, make(map[types.Type]bool)) {
+			continue
+		}
+		o, index, _ := types.LookupFieldOrMethod(t, true, r.p.Types, to)
+		switch {
+		case o != nil:
+			return fmt.Sprintf("it would collide with %s declared at %s", o.Name(), r.p.Fset.Position(o.Pos()))
+		case index != nil:
+			return fmt.Sprintf("it would collide with more than one promoted %s in %s", to, t)
+		}
+	}
+	return ""
+}
+
+// structTypes returns the types declared in the package, and the anonymous struct types, whose fields can be selected
+func (p *Package) structTypes() []types.Type {
+	var names []*types.TypeName
+	for _, obj := range p.Info.Defs {
+		if tn, ok := obj.(*types.TypeName); ok && !tn.IsAlias() {
+			names = append(names, tn)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i].Pos() < names[j].Pos()
+	})
+
+	var ret []types.Type
+	for _, tn := range names {
+		ret = append(ret, tn.Type())
+	}
+	seen := make(map[*types.Struct]bool)
+	for _, s := range p.structOf {
+		if !seen[s] {
+			seen[s] = true
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+// embeds returns true if t is a struct that embeds s, directly or through other embedded structs
+func embeds(t types.Type, s *types.Struct, seen map[types.Type]bool) bool {
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok || seen[t] {
+		return false
+	}
+	seen[t] = true
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if !f.Embedded() {
+			continue
+		}
+		ft := f.Type()
+		if ptr, ok := ft.(*types.Pointer); ok {
+			ft = ptr.Elem()
+		}
+		if ft.Underlying() == s || embeds(ft, s, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// refuseScoped checks an object can be renamed without colliding with, shadowing or being shadowed by another
+func (r *planner) refuseScoped(obj types.Object, to string) string {
+	scope := obj.Parent()
+	if scope == nil {
+		return "its scope couldn't be found"
+	}
+
+	if o := r.lookup(scope, to); o != nil && o != obj {
+		return fmt.Sprintf("it would collide with the declaration at %s", r.p.Fset.Position(o.Pos()))
+	}
+	if scope == r.p.Types.Scope() {
+		for i := 0; i < scope.NumChildren(); i++ {
+			if o := r.lookup(scope.Child(i), to); o != nil {
+				return fmt.Sprintf("it would collide with the import at %s", r.p.Fset.Position(o.Pos()))
+			}
+		}
+	}
+
+	// every use of obj must still refer to it, rather than something declared in between
+	for _, id := range r.uses[obj] {
+		for s := r.p.Types.Scope().Innermost(id.Pos()); s != nil && s != scope; s = s.Parent() {
+			if o := r.lookup(s, to); o != nil && o.Pos() < id.Pos() {
+				return fmt.Sprintf("it would be shadowed by the declaration at %s", r.p.Fset.Position(o.Pos()))
+			}
+		}
+	}
+
+	// anything from an outer scope that's already called 'to' mustn't be used where obj is visible
+	for o, ids := range r.uses {
+		if o == obj || r.name(o) != to || o.Parent() == nil || !encloses(o.Parent(), scope) {
+			continue
+		}
+		for _, id := range ids {
+			if r.visibleAt(obj, id.Pos()) {
+				return fmt.Sprintf("it would shadow %s used at %s", to, r.p.Fset.Position(id.Pos()))
+			}
+		}
+	}
+	return ""
+}
+
+// encloses returns true if outer is a (strict) ancestor of inner
+func encloses(outer, inner *types.Scope) bool {
+	for s := inner.Parent(); s != nil; s = s.Parent() {
+		if s == outer {
+			return true
+		}
+	}
+	return false
+}
+
+// visibleAt returns true if obj is visible at the given position
+func (r *planner) visibleAt(obj types.Object, pos token.Pos) bool {
+	scope := obj.Parent()
+	if scope == r.p.Types.Scope() {
+		return true
+	}
+	return scope.Contains(pos) && obj.Pos() < pos
+}
+
+// Apply returns the new content of each file changed by the given renames, keyed by file name.
+// The files are formatted with go/format, as longer or shorter names can change the alignment of the code around them
+func (p *Package) Apply(renames []Rename) (map[string][]byte, error) {
+	to := make(map[types.Object]string)
+	for _, rn := range renames {
+		to[rn.Object] = rn.To
+	}
+
+	edits := make(map[*ast.File][]edit)
+	add := func(id *ast.Ident, obj types.Object) {
+		name, ok := to[obj]
+		if !ok {
+			return
+		}
+		f := p.fileOf(id.Pos())
+		off := p.Fset.Position(id.Pos()).Offset
+		edits[f] = append(edits[f], edit{offset: off, length: len(id.Name), text: name})
+	}
+	for id, obj := range p.Info.Defs {
+		add(id, obj)
+	}
+	for id, obj := range p.Info.Uses {
+		add(id, obj)
+	}
+
+	ret := make(map[string][]byte)
+	for f, e := range edits {
+		src, err := format.Source(applyEdits(p.src[f], e))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p.names[f], err)
+		}
+		ret[p.names[f]] = src
+	}
+	return ret, nil
+}
+
+// fileOf finds the file containing the given position
+func (p *Package) fileOf(pos token.Pos) *ast.File {
+	for _, f := range p.Files {
+		if f.FileStart <= pos && pos <= f.FileEnd {
+			return f
+		}
+	}
+	return nil
+}
+
+// edit replaces length bytes at offset with text
+type edit struct {
+	offset int
+	length int
+	text   string
+}
+
+// applyEdits returns a copy of src with the given (non-overlapping) edits applied
+func applyEdits(src []byte, edits []edit) []byte {
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].offset < edits[j].offset
+	})
+	var b bytes.Buffer
+	at := 0
+	for _, e := range edits {
+		if e.offset < at {
+			continue // the same identifier recorded twice
+		}
+		b.Write(src[at:e.offset])
+		b.WriteString(e.text)
+		at = e.offset + e.length
+	}
+	b.Write(src[at:])
+	return b.Bytes()
+}
@@ -0,0 +1,266 @@
+package gorename
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// helperPackage writes the given files to a temporary directory and loads them as a package
+func helperPackage(t *testing.T, files map[string]string) *Package {
+	t.Helper()
+	dir := t.TempDir()
+	for name, src := range files {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o600)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+	}
+	p, err := Load(dir)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	return p
+}
+
+// helperSummary describes renames and refusals as "from->to" strings
+func helperSummary(renames []Rename, refusals []Refusal) ([]string, []string) {
+	var rn, rf []string
+	for _, r := range renames {
+		rn = append(rn, r.From+"->"+r.To)
+	}
+	for _, r := range refusals {
+		rf = append(rf, r.From+"->"+r.To)
+	}
+	sort.Strings(rn)
+	sort.Strings(rf)
+	return rn, rf
+}
+
+// TestPackage_Plan provides unit test coverage for Package.Plan()
+func TestPackage_Plan(t *testing.T) {
+	tests := []struct {
+		name         string
+		src          string
+		conv         Convention
+		opts         Options
+		wantRenames  []string
+		wantRefusals []string
+	}{
+		{
+			name: "nothing to do",
+			src:  "package p\n\nfunc f(userID int) int { return userID }\n",
+			conv: MixedCaps,
+		},
+		{
+			name:        "locals and params",
+			src:         "package p\n\nfunc f(user_id int) int {\n\tmax_count := user_id * 2\n\treturn max_count\n}\n",
+			conv:        MixedCaps,
+			wantRenames: []string{"max_count->maxCount", "user_id->userID"},
+		},
+		{
+			name:        "unexported package level",
+			src:         "package p\n\nconst max_size = 2\n\ntype user_record struct{ first_name string }\n",
+			conv:        MixedCaps,
+			wantRenames: []string{"first_name->firstName", "max_size->maxSize", "user_record->userRecord"},
+		},
+		{
+			name:         "exported is API",
+			src:          "package p\n\nvar Max_Size = 2\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"Max_Size->MaxSize"},
+		},
+		{
+			name:        "exported allowed",
+			src:         "package p\n\nvar Max_Size = 2\n",
+			conv:        MixedCaps,
+			opts:        Options{Exported: true},
+			wantRenames: []string{"Max_Size->MaxSize"},
+		},
+		{
+			name:         "collision in scope",
+			src:          "package p\n\nfunc f() int {\n\tuser_id, userID := 1, 2\n\treturn user_id + userID\n}\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"user_id->userID"},
+		},
+		{
+			name:         "collision between renames",
+			src:          "package p\n\nfunc f() int {\n\tuser_id, user_Id := 1, 2\n\treturn user_id + user_Id\n}\n",
+			conv:         MixedCaps,
+			wantRenames:  []string{"user_id->userID"},
+			wantRefusals: []string{"user_Id->userID"},
+		},
+		{
+			name:         "would be shadowed",
+			src:          "package p\n\nfunc f(max_n int) int {\n\tfor maxN := 0; maxN < 1; maxN++ {\n\t\treturn max_n\n\t}\n\treturn 0\n}\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"max_n->maxN"},
+		},
+		{
+			name:         "would shadow",
+			src:          "package p\n\nvar maxN = 1\n\nfunc f() int {\n\tmax_n := 2\n\treturn max_n + maxN\n}\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"max_n->maxN"},
+		},
+		{
+			name:        "same name in another scope is fine",
+			src:         "package p\n\nfunc f() int {\n\tmax_n := 2\n\treturn max_n\n}\n\nfunc g() int {\n\tmaxN := 2\n\treturn maxN\n}\n",
+			conv:        MixedCaps,
+			wantRenames: []string{"max_n->maxN"},
+		},
+		{
+			name:         "would shadow an import",
+			src:          "package p\n\nimport \"strings\"\n\nfunc f(s_trings string) string {\n\treturn strings.ToLower(s_trings)\n}\n",
+			conv:         Convention{Violates: MixedCaps.Violates, Unexported: func(string) string { return "strings" }},
+			wantRefusals: []string{"s_trings->strings"},
+		},
+		{
+			name:         "field collision",
+			src:          "package p\n\ntype t struct {\n\tuser_id int\n\tuserID  int\n}\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"user_id->userID"},
+		},
+		{
+			name:         "field collides with method",
+			src:          "package p\n\ntype t struct{ user_id int }\n\nfunc (t) userID() int { return 0 }\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"user_id->userID"},
+		},
+		{
+			name:         "promoted field collision",
+			src:          "package p\n\ntype inner struct{ user_id int }\n\ntype outer struct {\n\tinner\n\tuserID string\n}\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"user_id->userID"},
+		},
+		{
+			name:         "promoted through two levels",
+			src:          "package p\n\ntype inner struct{ user_id int }\n\ntype middle struct{ *inner }\n\ntype outer struct {\n\tmiddle\n\tuserID string\n}\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"user_id->userID"},
+		},
+		{
+			name:         "promoted into an anonymous struct",
+			src:          "package p\n\ntype inner struct{ user_id int }\n\nvar v struct {\n\tinner\n\tuserID string\n}\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"user_id->userID"},
+		},
+		{
+			name:        "promoted without collision",
+			src:         "package p\n\ntype inner struct{ user_id int }\n\ntype outer struct {\n\tinner\n\tname string\n}\n",
+			conv:        MixedCaps,
+			wantRenames: []string{"user_id->userID"},
+		},
+		{
+			name:         "methods",
+			src:          "package p\n\ntype t struct{}\n\nfunc (t) get_id() int { return 0 }\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"get_id->getID"},
+		},
+		{
+			name:         "embedded",
+			src:          "package p\n\ntype base_t struct{}\n\ntype t struct{ base_t }\n",
+			conv:         MixedCaps,
+			wantRefusals: []string{"base_t->baseT"},
+		},
+		{
+			name:        "lint",
+			src:         "package p\n\nfunc f(userId int) int { return userId }\n",
+			conv:        Lint,
+			wantRenames: []string{"userId->userID"},
+		},
+		{
+			name: "lint not applied",
+			src:  "package p\n\nfunc f(userId int) int { return userId }\n",
+			conv: MixedCaps,
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := helperPackage(t, map[string]string{"p.go": tt.src})
+			renames, refusals := p.Plan(tt.conv, tt.opts)
+			gotRenames, gotRefusals := helperSummary(renames, refusals)
+			assert.Equal(t, tt.wantRenames, gotRenames)
+			assert.Equal(t, tt.wantRefusals, gotRefusals)
+			for _, r := range refusals {
+				assert.NotEmpty(t, r.Reason)
+			}
+		})
+	}
+}
+
+// TestPackage_Apply provides unit test coverage for Package.Apply()
+func TestPackage_Apply(t *testing.T) {
+	p := helperPackage(t, map[string]string{
+		"p.go": "package p\n\ntype user_record struct{ first_name string }\n\n" +
+			"func new_record(first_name string) user_record {\n\treturn user_record{first_name: first_name}\n}\n",
+		"p_test.go": "package p\n\nimport \"testing\"\n\n" +
+			"func TestRecord(t *testing.T) {\n\tr := new_record(\"x\")\n\t_ = r.first_name\n}\n",
+	})
+	renames, refusals := p.Plan(MixedCaps, Options{})
+	assert.Empty(t, refusals)
+
+	got, err := p.Apply(renames)
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t,
+		"package p\n\ntype userRecord struct{ firstName string }\n\n"+
+			"func newRecord(firstName string) userRecord {\n\treturn userRecord{firstName: firstName}\n}\n",
+		string(got[filepath.Join(p.Dir, "p.go")]))
+	assert.Equal(t,
+		"package p\n\nimport \"testing\"\n\n"+
+			"func TestRecord(t *testing.T) {\n\tr := newRecord(\"x\")\n\t_ = r.firstName\n}\n",
+		string(got[filepath.Join(p.Dir, "p_test.go")]))
+}
+
+// TestPackage_Apply_format checks the changed files are gofmt'd, as renames can change the alignment of the code
+func TestPackage_Apply_format(t *testing.T) {
+	p := helperPackage(t, map[string]string{
+		"p.go": "package p\n\ntype t struct {\n\tmax_size_in_bytes int\n\tname              string\n}\n",
+	})
+	renames, refusals := p.Plan(MixedCaps, Options{})
+	assert.Empty(t, refusals)
+
+	got, err := p.Apply(renames)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"package p\n\ntype t struct {\n\tmaxSizeInBytes int\n\tname           string\n}\n",
+		string(got[filepath.Join(p.Dir, "p.go")]))
+}
+
+// TestPackage_Apply_xtest checks exported renames are made in the external test package too
+func TestPackage_Apply_xtest(t *testing.T) {
+	p := helperPackage(t, map[string]string{
+		"go.mod": "module example.com/p\n\ngo 1.21\n",
+		"p.go":   "package p\n\nvar Max_Size = 1\n",
+		"p_x_test.go": "package p_test\n\nimport (\n\t\"testing\"\n\n\t\"example.com/p\"\n)\n\n" +
+			"func TestMax(t *testing.T) { _ = p.Max_Size }\n",
+	})
+	renames, refusals := p.Plan(MixedCaps, Options{Exported: true})
+	assert.Empty(t, refusals)
+
+	got, err := p.Apply(renames)
+	assert.NoError(t, err)
+	assert.Equal(t, "package p\n\nvar MaxSize = 1\n", string(got[filepath.Join(p.Dir, "p.go")]))
+	assert.Equal(t,
+		"package p_test\n\nimport (\n\t\"testing\"\n\n\t\"example.com/p\"\n)\n\n"+
+			"func TestMax(t *testing.T) { _ = p.MaxSize }\n",
+		string(got[filepath.Join(p.Dir, "p_x_test.go")]))
+}
+
+// TestLoad provides unit test coverage for Load() failures
+func TestLoad(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+
+	dir := t.TempDir()
+	err = os.WriteFile(filepath.Join(dir, "p.go"), []byte("package p\n\nvar x int = \"s\"\n"), 0o600)
+	assert.NoError(t, err)
+	_, err = Load(dir)
+	assert.Error(t, err)
+}
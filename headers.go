@@ -0,0 +1,168 @@
+package wordcase
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// TrainCase creates a string from tokens by making the first rune of each token uppercase and joining them with
+// hyphens, eg "content type" -> "Content-Type"
+var TrainCase = NewPipeline().
+	TokenizeUsing(LookAroundCategorizer, NotLetterOrDigit, true).
+	TokenizeUsing(LookAroundCategorizer, NotLowerOrDigit, false).
+	WithAllFormatter(strings.ToLower).
+	WithAllFormatter(UppercaseFirst).
+	WithFormatter(strings.ToUpper, LintWords).
+	JoinWith("-")
+
+// HTTPKeyWords are words that are written in uppercase in HTTP header names, eg "X-Request-ID", "WWW-Authenticate"
+var HTTPKeyWords = []string{
+	"api",
+	"csp",
+	"csrf",
+	"dns",
+	"dnt",
+	"http",
+	"https",
+	"id",
+	"ip",
+	"md5",
+	"p3p",
+	"te",
+	"tls",
+	"ua",
+	"uri",
+	"url",
+	"uuid",
+	"www",
+	"xsrf",
+	"xss",
+}
+
+// HTTPWordForms are words with an unusual case in HTTP header names, by their lowercase form, eg "ETag"
+var HTTPWordForms = map[string]string{
+	"etag":      "ETag",
+	"webkit":    "WebKit",
+	"websocket": "WebSocket",
+}
+
+// HeaderRules describe how the words in HTTP header names are cased
+type HeaderRules struct {
+	KeyWords []string          // words written in uppercase (see KeyWordFn)
+	Forms    map[string]string // words written in an unusual case, by their lowercase form
+}
+
+// HTTPHeaders are the rules for standard HTTP header names
+var HTTPHeaders = HeaderRules{
+	KeyWords: HTTPKeyWords,
+	Forms:    HTTPWordForms,
+}
+
+// HeaderCase converts a string in any style into an HTTP header name, eg "x_request_id" -> "X-Request-ID"
+var HeaderCase = HTTPHeaders.Style()
+
+// CanonicalHeaderKey returns the canonical form of an HTTP header name with the HTTPHeaders rules
+// (see HeaderRules.CanonicalKey), eg "x-request-id" -> "X-Request-ID", "www-authenticate" -> "WWW-Authenticate"
+func CanonicalHeaderKey(s string) string {
+	return HTTPHeaders.CanonicalKey(s)
+}
+
+// CanonicalizeHeader renames the keys of h to their canonical form with the HTTPHeaders rules (see HeaderRules.Canonicalize)
+func CanonicalizeHeader(h http.Header) {
+	HTTPHeaders.Canonicalize(h)
+}
+
+// HeaderKeyEqual returns true if the strings are the same HTTP header name in any style with the HTTPHeaders rules,
+// eg "X-Request-ID", "x-request-id" and "xRequestId"
+func HeaderKeyEqual(a, b string) bool {
+	return strings.EqualFold(HeaderCase(a), HeaderCase(b))
+}
+
+// Style returns a Combiner that converts a string in any style into a header name following the rules,
+// eg "xRequestId" -> "X-Request-ID", "etag" -> "ETag"
+func (r HeaderRules) Style() Combiner {
+	tokenizer := Pipeline(func(s string) Tokens {
+		return r.mergeForms(Tokenizer(s))
+	})
+	return tokenizer.
+		WithAllFormatter(strings.ToLower).
+		WithAllFormatter(UppercaseFirst).
+		WithFormatter(strings.ToUpper, KeyWordFn(r.KeyWords)).
+		WithAllFormatter(r.form).
+		JoinWith("-")
+}
+
+// CanonicalKey returns the canonical form of a header name following the rules, changing only the case of its letters,
+// so it's equal to net/textproto.CanonicalMIMEHeaderKey ignoring case.
+//
+//	The words of the name are separated by hyphens only, and names that aren't valid (eg that contain spaces)
+//	are returned unchanged, as they are by CanonicalMIMEHeaderKey
+func (r HeaderRules) CanonicalKey(s string) string {
+	for i := 0; i < len(s); i++ {
+		if !isHeaderTokenByte(s[i]) {
+			return s
+		}
+	}
+	return Tokens(strings.Split(s, "-")).
+		FormatAll(strings.ToLower).
+		FormatAll(UppercaseFirst).
+		Format(strings.ToUpper, KeyWordFn(r.KeyWords)).
+		FormatAll(r.form).
+		Join("-")
+}
+
+// Canonicalize renames the keys of h to their canonical form, merging the values of any keys that become the same.
+//
+//	Note that http.Header's methods find keys by their net/textproto canonical form, so after renaming
+//	a key such as "X-Request-Id" to "X-Request-ID" its values must be accessed directly. This is usually done just
+//	before writing headers, eg in middleware, so they're sent with the preferred names
+func (r HeaderRules) Canonicalize(h http.Header) {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if c := r.CanonicalKey(k); c != k {
+			h[c] = append(h[c], h[k]...)
+			delete(h, k)
+		}
+	}
+}
+
+// Equal returns true if the strings are the same header name, in any style.
+// It builds the rules' Style on each call, so keep a Style to compare many names
+func (r HeaderRules) Equal(a, b string) bool {
+	s := r.Style()
+	return strings.EqualFold(s(a), s(b))
+}
+
+// form returns the unusual form of a word, if it has one
+func (r HeaderRules) form(s string) string {
+	if f, ok := r.Forms[strings.ToLower(s)]; ok {
+		return f
+	}
+	return s
+}
+
+// mergeForms joins adjacent tokens that together make a word with an unusual form, eg "E", "Tag" -> "ETag"
+func (r HeaderRules) mergeForms(t Tokens) Tokens {
+	ret := Tokens{}
+	for i := 0; i < len(t); i++ {
+		merged, next := t[i], i
+		for j := i + 1; j < len(t); j++ {
+			if _, ok := r.Forms[strings.ToLower(strings.Join(t[i:j+1], ""))]; ok {
+				merged, next = strings.Join(t[i:j+1], ""), j
+			}
+		}
+		ret = append(ret, merged)
+		i = next
+	}
+	return ret
+}
+
+// isHeaderTokenByte returns true for the bytes allowed in a header name (an RFC 7230 token)
+func isHeaderTokenByte(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || strings.IndexByte("!#$%&'*+-.^_`|~", c) >= 0
+}
@@ -0,0 +1,147 @@
+package wordcase
+
+import (
+	"net/http"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTrainCase provides unit test coverage for TrainCase()
+func TestTrainCase(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "", want: ""},
+		{s: "content type", want: "Content-Type"},
+		{s: "x_request_id", want: "X-Request-ID"},
+		{s: "XMLHttpRequest", want: "XML-HTTP-Request"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			got := TrainCase(tt.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestHeaderCase provides unit test coverage for HeaderCase()
+func TestHeaderCase(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "", want: ""},
+		{s: "x-request-id", want: "X-Request-ID"},
+		{s: "xRequestId", want: "X-Request-ID"},
+		{s: "X_REQUEST_ID", want: "X-Request-ID"},
+		{s: "www authenticate", want: "WWW-Authenticate"},
+		{s: "content_md5", want: "Content-MD5"},
+		{s: "etag", want: "ETag"},
+		{s: "ETag", want: "ETag"},
+		{s: "sec-websocket-key", want: "Sec-WebSocket-Key"},
+		{s: "SecWebSocketKey", want: "Sec-WebSocket-Key"},
+		{s: "x-xss-protection", want: "X-XSS-Protection"},
+		{s: "dnt", want: "DNT"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			got := HeaderCase(tt.s)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, got, HeaderCase(got), "idempotent")
+		})
+	}
+}
+
+// TestCanonicalHeaderKey provides unit test coverage for CanonicalHeaderKey()
+func TestCanonicalHeaderKey(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "", want: ""},
+		{s: "x-request-id", want: "X-Request-ID"},
+		{s: "X-REQUEST-ID", want: "X-Request-ID"},
+		{s: "www-authenticate", want: "WWW-Authenticate"},
+		{s: "content-md5", want: "Content-MD5"},
+		{s: "etag", want: "ETag"},
+		{s: "sec-websocket-accept", want: "Sec-WebSocket-Accept"},
+		{s: "content-type", want: "Content-Type"},
+		{s: "x_custom_id", want: "X_custom_id"},
+		{s: "a--b", want: "A--B"},
+		{s: "xRequestId", want: "Xrequestid"},
+		{s: "not valid", want: "not valid"},
+		{s: "bad:colon", want: "bad:colon"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			got := CanonicalHeaderKey(tt.s)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, strings.EqualFold(textproto.CanonicalMIMEHeaderKey(tt.s), got), "compatible with textproto")
+		})
+	}
+}
+
+// TestHeaderRules provides unit test coverage for custom HeaderRules
+func TestHeaderRules(t *testing.T) {
+	rules := HeaderRules{
+		KeyWords: append([]string{"amz"}, HTTPKeyWords...),
+		Forms:    map[string]string{"github": "GitHub"},
+	}
+	assert.Equal(t, "X-AMZ-Request-ID", rules.Style()("x-amz-request-id"))
+	assert.Equal(t, "X-GitHub-Event", rules.Style()("x_github_event"))
+	assert.Equal(t, "X-GitHub-Event", rules.CanonicalKey("x-github-event"))
+	assert.Equal(t, "X-Amz-Date", CanonicalHeaderKey("x-amz-date"))
+}
+
+// TestCanonicalizeHeader provides unit test coverage for CanonicalizeHeader()
+func TestCanonicalizeHeader(t *testing.T) {
+	h := http.Header{}
+	h.Set("X-Request-Id", "1")
+	h["x-request-id"] = []string{"2"}
+	h.Set("Content-Type", "text/plain")
+	h.Set("Www-Authenticate", "Basic")
+
+	CanonicalizeHeader(h)
+	assert.Equal(t, http.Header{
+		"X-Request-ID":     {"1", "2"},
+		"Content-Type":     {"text/plain"},
+		"WWW-Authenticate": {"Basic"},
+	}, h)
+}
+
+// TestHeaderKeyEqual provides unit test coverage for HeaderKeyEqual()
+func TestHeaderKeyEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "X-Request-ID", b: "x-request-id", want: true},
+		{a: "X-Request-ID", b: "xRequestId", want: true},
+		{a: "X-Request-ID", b: "X_REQUEST_ID", want: true},
+		{a: "ETag", b: "etag", want: true},
+		{a: "X-Request-ID", b: "X-Request", want: false},
+		{a: "", b: "", want: true},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.a+"="+tt.b, func(t *testing.T) {
+			t.Parallel()
+			got := HeaderKeyEqual(tt.a, tt.b)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package wordcase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// IdentifierRules describe what makes a valid identifier in a programming language, and how to fix one that isn't
+type IdentifierRules struct {
+	Reserved     WordSet         // words that can't be used as identifiers
+	FoldReserved bool            // set to true if reserved words are matched regardless of case (Reserved must be lowercase)
+	Escape       Formatter       // converts a reserved word into a usable identifier
+	DigitPrefix  string          // prepended to identifiers that would otherwise start with a digit
+	Allowed      func(rune) bool // returns true for runes allowed in an identifier
+	Replacement  string          // replaces each rune that isn't allowed
+}
+
+// Apply returns a Combiner that converts a string with the given style, then fixes the result to follow the rules.
+//
+//	Runes that aren't allowed are replaced, identifiers starting with a digit are prefixed, and reserved words escaped.
+//	An empty result is returned unchanged
+func (r IdentifierRules) Apply(style Combiner) Combiner {
+	return func(s string) string {
+		id := style(s)
+		if id == "" {
+			return id
+		}
+		if r.Allowed != nil {
+			id = r.replaceDisallowed(id)
+		}
+		if c, _ := utf8.DecodeRuneInString(id); unicode.IsDigit(c) {
+			id = r.DigitPrefix + id
+		}
+		if r.IsReserved(id) && r.Escape != nil {
+			id = r.Escape(id)
+		}
+		return id
+	}
+}
+
+// IsReserved returns true if the given word can't be used as an identifier as-is
+func (r IdentifierRules) IsReserved(word string) bool {
+	if r.FoldReserved {
+		word = strings.ToLower(word)
+	}
+	return r.Reserved.Has(word)
+}
+
+// replaceDisallowed swaps every rune in s that isn't allowed for the replacement
+func (r IdentifierRules) replaceDisallowed(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		if r.Allowed(c) {
+			b.WriteRune(c)
+		} else {
+			b.WriteString(r.Replacement)
+		}
+	}
+	return b.String()
+}
+
+// TrailingUnderscore escapes a word by appending an underscore, eg "type" -> "type_"
+func TrailingUnderscore(s string) string {
+	return s + "_"
+}
+
+// BacktickQuote escapes a word by surrounding it with backticks, eg "type" -> "`type`"
+func BacktickQuote(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
+
+// DoubleQuote escapes a word by surrounding it with double quotes, as used for SQL identifiers, eg "user" -> `"user"`
+func DoubleQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// RustRawIdentifier escapes a word using Rust's raw identifier syntax, eg "type" -> "r#type".
+//
+//	The words that can't be raw identifiers ("crate", "self", "super" and "Self") have an underscore appended instead
+func RustRawIdentifier(s string) string {
+	switch s {
+	case "crate", "self", "super", "Self":
+		return TrailingUnderscore(s)
+	}
+	return "r#" + s
+}
+
+// isLetterDigitOrUnderscore returns true for the runes most languages allow in an identifier
+func isLetterDigitOrUnderscore(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// isIdentifierOrDollar returns true for the runes allowed in a Java or JavaScript identifier
+func isIdentifierOrDollar(r rune) bool {
+	return r == '$' || isLetterDigitOrUnderscore(r)
+}
+
+// isASCIIIdentifier returns true for the runes allowed in an unquoted SQL identifier
+func isASCIIIdentifier(r rune) bool {
+	return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+// GoReservedWords are the keywords of the Go language
+var GoReservedWords = []string{
+	"break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "for", "func", "go",
+	"goto", "if", "import", "interface", "map", "package", "range", "return", "select", "struct", "switch", "type",
+	"var",
+}
+
+// PythonReservedWords are the keywords of the Python language
+var PythonReservedWords = []string{
+	"False", "None", "True", "and", "as", "assert", "async", "await", "break", "class", "continue", "def", "del",
+	"elif", "else", "except", "finally", "for", "from", "global", "if", "import", "in", "is", "lambda", "nonlocal",
+	"not", "or", "pass", "raise", "return", "try", "while", "with", "yield",
+}
+
+// JavaReservedWords are the keywords and literals of the Java language
+var JavaReservedWords = []string{
+	"_", "abstract", "assert", "boolean", "break", "byte", "case", "catch", "char", "class", "const", "continue",
+	"default", "do", "double", "else", "enum", "extends", "false", "final", "finally", "float", "for", "goto", "if",
+	"implements", "import", "instanceof", "int", "interface", "long", "native", "new", "null", "package", "private",
+	"protected", "public", "return", "short", "static", "strictfp", "super", "switch", "synchronized", "this", "throw",
+	"throws", "transient", "true", "try", "void", "volatile", "while",
+}
+
+// RustReservedWords are the strict and reserved keywords of the Rust language
+var RustReservedWords = []string{
+	"Self", "abstract", "as", "async", "await", "become", "box", "break", "const", "continue", "crate", "do", "dyn",
+	"else", "enum", "extern", "false", "final", "fn", "for", "if", "impl", "in", "let", "loop", "macro", "match", "mod",
+	"move", "mut", "override", "priv", "pub", "ref", "return", "self", "static", "struct", "super", "trait", "true",
+	"try", "type", "typeof", "unsafe", "unsized", "use", "virtual", "where", "while", "yield",
+}
+
+// TypeScriptReservedWords are the reserved words of TypeScript (and JavaScript in strict mode)
+var TypeScriptReservedWords = []string{
+	"await", "break", "case", "catch", "class", "const", "continue", "debugger", "default", "delete", "do", "else",
+	"enum", "export", "extends", "false", "finally", "for", "function", "if", "implements", "import", "in",
+	"instanceof", "interface", "let", "new", "null", "package", "private", "protected", "public", "return", "static",
+	"super", "switch", "this", "throw", "true", "try", "typeof", "var", "void", "while", "with", "yield",
+}
+
+// SQLReservedWords are commonly reserved words of standard SQL
+var SQLReservedWords = []string{
+	"all", "alter", "and", "any", "as", "asc", "between", "by", "case", "cast", "check", "column", "constraint",
+	"create", "cross", "current_date", "current_time", "current_timestamp", "current_user", "default", "delete",
+	"desc", "distinct", "drop", "else", "end", "except", "exists", "false", "fetch", "for", "foreign", "from", "full",
+	"grant", "group", "having", "in", "inner", "insert", "intersect", "into", "is", "join", "left", "like", "limit",
+	"natural", "not", "null", "offset", "on", "or", "order", "outer", "primary", "references", "right", "select",
+	"session_user", "set", "some", "table", "then", "to", "true", "union", "unique", "update", "user", "using",
+	"values", "when", "where", "with",
+}
+
+// GoRules are the identifier rules for Go
+var GoRules = IdentifierRules{
+	Reserved:    NewWordSet(GoReservedWords...),
+	Escape:      TrailingUnderscore,
+	DigitPrefix: "_",
+	Allowed:     isLetterDigitOrUnderscore,
+	Replacement: "_",
+}
+
+// PythonRules are the identifier rules for Python
+var PythonRules = IdentifierRules{
+	Reserved:    NewWordSet(PythonReservedWords...),
+	Escape:      TrailingUnderscore,
+	DigitPrefix: "_",
+	Allowed:     isLetterDigitOrUnderscore,
+	Replacement: "_",
+}
+
+// JavaRules are the identifier rules for Java
+var JavaRules = IdentifierRules{
+	Reserved:    NewWordSet(JavaReservedWords...),
+	Escape:      TrailingUnderscore,
+	DigitPrefix: "_",
+	Allowed:     isIdentifierOrDollar,
+	Replacement: "_",
+}
+
+// RustRules are the identifier rules for Rust
+var RustRules = IdentifierRules{
+	Reserved:    NewWordSet(RustReservedWords...),
+	Escape:      RustRawIdentifier,
+	DigitPrefix: "_",
+	Allowed:     isLetterDigitOrUnderscore,
+	Replacement: "_",
+}
+
+// TypeScriptRules are the identifier rules for TypeScript
+var TypeScriptRules = IdentifierRules{
+	Reserved:    NewWordSet(TypeScriptReservedWords...),
+	Escape:      TrailingUnderscore,
+	DigitPrefix: "_",
+	Allowed:     isIdentifierOrDollar,
+	Replacement: "_",
+}
+
+// SQLRules are the identifier rules for standard SQL; reserved words are escaped by quoting them
+var SQLRules = IdentifierRules{
+	Reserved:     NewWordSet(SQLReservedWords...),
+	FoldReserved: true,
+	Escape:       DoubleQuote,
+	DigitPrefix:  "_",
+	Allowed:      isASCIIIdentifier,
+	Replacement:  "_",
+}
+
+// GoIdentifier converts a string into a camel cased Go identifier, eg "type" -> "type_", "2fa code" -> "_2faCode"
+var GoIdentifier = GoRules.Apply(CamelCase)
+
+// PythonIdentifier converts a string into a snake cased Python identifier, eg "class" -> "class_"
+var PythonIdentifier = PythonRules.Apply(SnakeCase)
+
+// JavaIdentifier converts a string into a camel cased Java identifier, eg "class" -> "class_"
+var JavaIdentifier = JavaRules.Apply(CamelCase)
+
+// RustIdentifier converts a string into a snake cased Rust identifier, eg "type" -> "r#type"
+var RustIdentifier = RustRules.Apply(SnakeCase)
+
+// TypeScriptIdentifier converts a string into a camel cased TypeScript identifier, eg "delete" -> "delete_"
+var TypeScriptIdentifier = TypeScriptRules.Apply(CamelCase)
+
+// SQLIdentifier converts a string into a snake cased SQL identifier, eg "user" -> `"user"`
+var SQLIdentifier = SQLRules.Apply(SnakeCase)
@@ -0,0 +1,98 @@
+package wordcase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLanguageIdentifiers provides unit test coverage for the language identifier Combiners
+func TestLanguageIdentifiers(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   Combiner
+		s    string
+		want string
+	}{
+		{name: "go", fn: GoIdentifier, s: "user id", want: "userID"},
+		{name: "go reserved", fn: GoIdentifier, s: "type", want: "type_"},
+		{name: "go reserved case", fn: GoIdentifier, s: "TYPE", want: "type_"},
+		{name: "go leading digit", fn: GoIdentifier, s: "2fa code", want: "_2faCode"},
+		{name: "go empty", fn: GoIdentifier, s: "$$", want: ""},
+		{name: "go exported", fn: GoRules.Apply(PascalCase), s: "type", want: "Type"},
+		{name: "python", fn: PythonIdentifier, s: "userID", want: "user_id"},
+		{name: "python reserved", fn: PythonIdentifier, s: "class", want: "class_"},
+		{name: "python reserved case sensitive", fn: PythonRules.Apply(PascalCase), s: "none", want: "None_"},
+		{name: "python leading digit", fn: PythonIdentifier, s: "2nd place", want: "_2nd_place"},
+		{name: "python disallowed", fn: PythonRules.Apply(KebabCase), s: "two words", want: "two_words"},
+		{name: "java", fn: JavaIdentifier, s: "class", want: "class_"},
+		{name: "java dollar allowed", fn: JavaRules.Apply(NewPipeline().JoinWith("")), s: "$x", want: "$x"},
+		{name: "rust", fn: RustIdentifier, s: "type", want: "r#type"},
+		{name: "rust not raw", fn: RustIdentifier, s: "self", want: "self_"},
+		{name: "rust Self", fn: RustRules.Apply(PascalCase), s: "self", want: "Self_"},
+		{name: "typescript", fn: TypeScriptIdentifier, s: "delete", want: "delete_"},
+		{name: "typescript fine", fn: TypeScriptIdentifier, s: "user name", want: "userName"},
+		{name: "sql", fn: SQLIdentifier, s: "user", want: `"user"`},
+		{name: "sql case insensitive", fn: SQLRules.Apply(ScreamingSnakeCase), s: "order", want: `"ORDER"`},
+		{name: "sql non ascii", fn: SQLIdentifier, s: "crème brûlée", want: "cr_me_br_l_e"},
+		{name: "sql leading digit", fn: SQLIdentifier, s: "1st", want: "_1st"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.fn(tt.s))
+		})
+	}
+}
+
+// TestIdentifierRules_IsReserved provides unit test coverage for IdentifierRules.IsReserved()
+func TestIdentifierRules_IsReserved(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules IdentifierRules
+		word  string
+		want  bool
+	}{
+		{name: "go", rules: GoRules, word: "func", want: true},
+		{name: "go case sensitive", rules: GoRules, word: "Func", want: false},
+		{name: "go not reserved", rules: GoRules, word: "string", want: false},
+		{name: "sql folded", rules: SQLRules, word: "SeLeCt", want: true},
+		{name: "zero value", rules: IdentifierRules{}, word: "func", want: false},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.rules.IsReserved(tt.word))
+		})
+	}
+}
+
+// TestEscapes provides unit test coverage for the reserved word escaping Formatters
+func TestEscapes(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   Formatter
+		s    string
+		want string
+	}{
+		{name: "trailing underscore", fn: TrailingUnderscore, s: "type", want: "type_"},
+		{name: "backtick", fn: BacktickQuote, s: "type", want: "`type`"},
+		{name: "backtick escaped", fn: BacktickQuote, s: "a`b", want: "`a``b`"},
+		{name: "double quote", fn: DoubleQuote, s: "user", want: `"user"`},
+		{name: "double quote escaped", fn: DoubleQuote, s: `a"b`, want: `"a""b"`},
+		{name: "rust raw", fn: RustRawIdentifier, s: "match", want: "r#match"},
+		{name: "rust crate", fn: RustRawIdentifier, s: "crate", want: "crate_"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.fn(tt.s))
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package wordcase
+
+import (
+	"math/bits"
+)
+
+// wordBits is the number of indices held by each word of an IndexSet
+const wordBits = 64
+
+// IndexSet is a set of token indices, bounded by the number of tokens it was created for.
+//
+//	Indices outside the range 0 <= i < Size() are never members, so selector output can be normalised
+//	into a set regardless of order, duplicates or bad addressing
+type IndexSet struct {
+	size int
+	bits []uint64
+}
+
+// NewIndexSet creates a set able to hold indices from 0 up to (but not including) size,
+// containing each of the given indices that fall into that range
+func NewIndexSet(size int, indices ...int) IndexSet {
+	if size < 0 {
+		size = 0
+	}
+	s := IndexSet{
+		size: size,
+		bits: make([]uint64, (size+wordBits-1)/wordBits),
+	}
+	for _, i := range indices {
+		s.add(i)
+	}
+	return s
+}
+
+// FullIndexSet creates a set containing every index from 0 up to (but not including) size
+func FullIndexSet(size int) IndexSet {
+	return NewIndexSet(size).Complement()
+}
+
+// Select normalises the output of the given selector into a set
+func (t Tokens) Select(sel TokenSelector) IndexSet {
+	return NewIndexSet(len(t), sel(t)...)
+}
+
+// add puts i in the set, ignoring out of range values
+func (s IndexSet) add(i int) {
+	if i < 0 || i >= s.size {
+		return
+	}
+	s.bits[i/wordBits] |= 1 << uint(i%wordBits)
+}
+
+// Size returns the number of indices the set can hold
+func (s IndexSet) Size() int {
+	return s.size
+}
+
+// Has returns true if i is in the set
+func (s IndexSet) Has(i int) bool {
+	if i < 0 || i >= s.size {
+		return false
+	}
+	return s.bits[i/wordBits]&(1<<uint(i%wordBits)) != 0
+}
+
+// Len returns the number of indices in the set
+func (s IndexSet) Len() int {
+	n := 0
+	for _, w := range s.bits {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Indices returns the members of the set in ascending order, or nil if the set is empty
+func (s IndexSet) Indices() []int {
+	var ret []int
+	for wi, w := range s.bits {
+		for w != 0 {
+			b := bits.TrailingZeros64(w)
+			ret = append(ret, wi*wordBits+b)
+			w &^= 1 << uint(b)
+		}
+	}
+	return ret
+}
+
+// Union returns a set of the indices in either s or o
+func (s IndexSet) Union(o IndexSet) IndexSet {
+	return s.combine(o, func(a, b uint64) uint64 { return a | b })
+}
+
+// Intersect returns a set of the indices in both s and o
+func (s IndexSet) Intersect(o IndexSet) IndexSet {
+	return s.combine(o, func(a, b uint64) uint64 { return a & b })
+}
+
+// Difference returns a set of the indices in s that aren't in o
+func (s IndexSet) Difference(o IndexSet) IndexSet {
+	return s.combine(o, func(a, b uint64) uint64 { return a &^ b })
+}
+
+// SymmetricDifference returns a set of the indices in exactly one of s or o
+func (s IndexSet) SymmetricDifference(o IndexSet) IndexSet {
+	return s.combine(o, func(a, b uint64) uint64 { return a ^ b })
+}
+
+// Complement returns a set of the indices in range that aren't in s
+func (s IndexSet) Complement() IndexSet {
+	r := NewIndexSet(s.size)
+	for i, w := range s.bits {
+		r.bits[i] = ^w
+	}
+	r.trim()
+	return r
+}
+
+// combine applies op word by word to s and o, giving a set as large as the bigger of the two
+func (s IndexSet) combine(o IndexSet, op func(a, b uint64) uint64) IndexSet {
+	r := NewIndexSet(max(s.size, o.size))
+	for i := range r.bits {
+		var a, b uint64
+		if i < len(s.bits) {
+			a = s.bits[i]
+		}
+		if i < len(o.bits) {
+			b = o.bits[i]
+		}
+		r.bits[i] = op(a, b)
+	}
+	r.trim()
+	return r
+}
+
+// trim clears any bits beyond the size of the set
+func (s IndexSet) trim() {
+	if rem := s.size % wordBits; rem != 0 {
+		s.bits[len(s.bits)-1] &= (1 << uint(rem)) - 1
+	}
+}
@@ -0,0 +1,193 @@
+package wordcase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewIndexSet provides unit test coverage for NewIndexSet()
+func TestNewIndexSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		size     int
+		indices  []int
+		want     []int
+		wantLen  int
+		wantSize int
+	}{
+		{
+			name:     "empty",
+			size:     0,
+			indices:  []int{0, 1},
+			want:     nil,
+			wantLen:  0,
+			wantSize: 0,
+		},
+		{
+			name:     "negative size",
+			size:     -3,
+			want:     nil,
+			wantSize: 0,
+		},
+		{
+			name:     "sorted and unique",
+			size:     3,
+			indices:  []int{2, 0, 2, 0},
+			want:     []int{0, 2},
+			wantLen:  2,
+			wantSize: 3,
+		},
+		{
+			name:     "out of range dropped",
+			size:     3,
+			indices:  []int{-1, 1, 3, 100},
+			want:     []int{1},
+			wantLen:  1,
+			wantSize: 3,
+		},
+		{
+			name:     "spans words",
+			size:     200,
+			indices:  []int{199, 63, 64, 0, 128},
+			want:     []int{0, 63, 64, 128, 199},
+			wantLen:  5,
+			wantSize: 200,
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewIndexSet(tt.size, tt.indices...)
+			assert.Equal(t, tt.want, got.Indices())
+			assert.Equal(t, tt.wantLen, got.Len())
+			assert.Equal(t, tt.wantSize, got.Size())
+			for _, i := range tt.want {
+				assert.True(t, got.Has(i))
+			}
+			assert.False(t, got.Has(-1))
+			assert.False(t, got.Has(tt.size))
+		})
+	}
+}
+
+// TestIndexSet_Operations provides unit test coverage for the IndexSet set operations
+func TestIndexSet_Operations(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        IndexSet
+		b        IndexSet
+		union    []int
+		inter    []int
+		diff     []int
+		symDiff  []int
+		compA    []int
+		fullSize int
+	}{
+		{
+			name:  "empty",
+			a:     NewIndexSet(0),
+			b:     NewIndexSet(0),
+			union: nil,
+		},
+		{
+			name:    "overlap",
+			a:       NewIndexSet(4, 0, 1),
+			b:       NewIndexSet(4, 1, 2),
+			union:   []int{0, 1, 2},
+			inter:   []int{1},
+			diff:    []int{0},
+			symDiff: []int{0, 2},
+			compA:   []int{2, 3},
+		},
+		{
+			name:    "different sizes",
+			a:       NewIndexSet(2, 1),
+			b:       NewIndexSet(70, 1, 69),
+			union:   []int{1, 69},
+			inter:   []int{1},
+			diff:    nil,
+			symDiff: []int{69},
+			compA:   []int{0},
+		},
+		{
+			name:    "word boundary",
+			a:       NewIndexSet(65, 64),
+			b:       NewIndexSet(65),
+			union:   []int{64},
+			inter:   nil,
+			diff:    []int{64},
+			symDiff: []int{64},
+			compA:   ToAll(make(Tokens, 64)),
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.union, tt.a.Union(tt.b).Indices())
+			assert.Equal(t, tt.inter, tt.a.Intersect(tt.b).Indices())
+			assert.Equal(t, tt.diff, tt.a.Difference(tt.b).Indices())
+			assert.Equal(t, tt.symDiff, tt.a.SymmetricDifference(tt.b).Indices())
+			assert.Equal(t, tt.compA, tt.a.Complement().Indices())
+		})
+	}
+}
+
+// TestFullIndexSet provides unit test coverage for FullIndexSet()
+func TestFullIndexSet(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: "empty", size: 0},
+		{name: "small", size: 3},
+		{name: "one word", size: 64},
+		{name: "over a word", size: 130},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := FullIndexSet(tt.size)
+			assert.Equal(t, tt.size, got.Len())
+			assert.False(t, got.Has(tt.size))
+		})
+	}
+}
+
+// TestTokens_Select provides unit test coverage for Tokens.Select()
+func TestTokens_Select(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Tokens
+		sel  TokenSelector
+		want []int
+	}{
+		{
+			name: "empty",
+			t:    Tokens{},
+			sel:  ToFirst,
+			want: nil,
+		},
+		{
+			name: "normalised",
+			t:    Tokens{"one", "two", "three"},
+			sel:  func(Tokens) []int { return []int{2, 2, -1, 0, 9} },
+			want: []int{0, 2},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.t.Select(tt.sel)
+			assert.Equal(t, tt.want, got.Indices())
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Package fields holds the helpers shared by the packages that walk the fields of a struct with reflection
+package fields
+
+import (
+	"encoding"
+	"reflect"
+	"time"
+)
+
+var (
+	// DurationType is the type of time.Duration, which is an int64 but parsed differently
+	DurationType = reflect.TypeOf(time.Duration(0))
+	// UnmarshalerType is the type of encoding.TextUnmarshaler
+	UnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Skip returns true for fields that can't be set from outside the package.
+// Embedded structs aren't skipped, as their exported fields are promoted even when their type isn't exported
+func Skip(f reflect.StructField) bool {
+	return !f.IsExported() && !(f.Anonymous && f.Type.Kind() == reflect.Struct)
+}
+
+// IsStruct returns true for struct types (or pointers to them) that are walked into, rather than set from text
+func IsStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && !reflect.PointerTo(t).Implements(UnmarshalerType)
+}
+
+// Guard holds the struct types on the path from the outermost struct to the one being walked, so a recursive type
+// (eg type Node struct{ Next *Node }) isn't walked into, or allocated, forever
+type Guard map[reflect.Type]bool
+
+// NewGuard creates a Guard for walking the struct type t
+func NewGuard(t reflect.Type) Guard {
+	g := Guard{}
+	g.Enter(t)
+	return g
+}
+
+// Enter adds the struct type t (or the type it points to) to the path, returning false if it's already on it,
+// in which case it mustn't be walked into
+func (g Guard) Enter(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if g[t] {
+		return false
+	}
+	g[t] = true
+	return true
+}
+
+// Leave removes the struct type t (or the type it points to) from the path, once it's been walked
+func (g Guard) Leave(t reflect.Type) {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	delete(g, t)
+}
+
+// Join joins the parts with the separator, if both are present
+func Join(a, sep, b string) string {
+	if a == "" {
+		return b
+	}
+	return a + sep + b
+}
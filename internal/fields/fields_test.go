@@ -0,0 +1,58 @@
+package fields
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type inner struct{ A int }
+
+type outer struct {
+	inner
+	*net.IP
+	B      inner
+	C      *inner
+	D      time.Duration
+	hidden int
+}
+
+// TestSkip provides unit test coverage for Skip()
+func TestSkip(t *testing.T) {
+	typ := reflect.TypeOf(outer{})
+	want := map[string]bool{"inner": false, "IP": false, "B": false, "C": false, "D": false, "hidden": true}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		assert.Equal(t, want[f.Name], Skip(f), f.Name)
+	}
+}
+
+// TestIsStruct provides unit test coverage for IsStruct()
+func TestIsStruct(t *testing.T) {
+	assert.True(t, IsStruct(reflect.TypeOf(inner{})))
+	assert.True(t, IsStruct(reflect.TypeOf(&inner{})))
+	assert.False(t, IsStruct(reflect.TypeOf(0)))
+	assert.False(t, IsStruct(reflect.TypeOf(time.Duration(0))))
+	assert.False(t, IsStruct(reflect.TypeOf(time.Time{})), "implements encoding.TextUnmarshaler")
+}
+
+// TestGuard provides unit test coverage for Guard
+func TestGuard(t *testing.T) {
+	type node struct{ Next *node }
+	g := NewGuard(reflect.TypeOf(node{}))
+	assert.False(t, g.Enter(reflect.TypeOf(&node{})), "already on the path")
+
+	assert.True(t, g.Enter(reflect.TypeOf(&inner{})))
+	assert.False(t, g.Enter(reflect.TypeOf(inner{})))
+	g.Leave(reflect.TypeOf(&inner{}))
+	assert.True(t, g.Enter(reflect.TypeOf(inner{})), "a sibling of the same type is walked")
+}
+
+// TestJoin provides unit test coverage for Join()
+func TestJoin(t *testing.T) {
+	assert.Equal(t, "b", Join("", "-", "b"))
+	assert.Equal(t, "a-b", Join("a", "-", "b"))
+}
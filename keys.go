@@ -0,0 +1,125 @@
+package wordcase
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// keyTokenizer breaks a string into the words of its canonical key
+var keyTokenizer = PluralSafeTokenizer
+
+// Key returns the canonical form of an identifier, so spellings of the same words in different styles can be compared,
+// eg "user_id", "userId", "UserID" and "USER-ID" all have the key "user_id".
+//
+//	The string is broken into words with PluralSafeTokenizer (so "UserIDs" matches "user_ids", but "CPU Is" stays two
+//	words),
+//	each word is case-folded, and the words are joined with "_". It follows that:
+//	 - two strings have the same key exactly when they have the same words, ignoring case
+//	 - separators and their number don't matter, eg "user__id", "user.id" and "user id" have the same key
+//	 - a key is its own key, and converting a string to one of the plural safe snake, kebab, dot, camel, pascal or
+//	   screaming snake case styles doesn't change its key, except where camel or pascal case runs initialisms
+//	   together, eg "XmlHttpRequest" -> "XMLHTTPRequest"
+//	 - the plain styles keep the key too, but only for strings without plural initialisms or upper case letters
+//	   next to digits, as they split "UTF8Decoder" into "ut_f8_decoder" and "user_ID2" into "user_i_d2"
+//	 - a string with no letters or digits has the key ""
+func Key(s string) string {
+	return keyTokens(s).Join("_")
+}
+
+// keyTokens returns the case-folded tokens of an identifier, as used by Key
+func keyTokens(s string) Tokens {
+	return keyTokenizer(s).FormatAll(foldCase)
+}
+
+// Equal returns true if the strings have the same canonical Key, eg Equal("user_id", "UserID")
+func Equal(a, b string) bool {
+	return Key(a) == Key(b)
+}
+
+// foldCase maps each rune to a single form for all its cases, eg "ſ" (long s) and "S" both to "s"
+func foldCase(s string) string {
+	return strings.Map(func(r rune) rune {
+		return unicode.ToLower(unicode.ToUpper(r))
+	}, s)
+}
+
+// AmbiguousKeyError reports an insert into a Map using a different spelling of a name it already has
+type AmbiguousKeyError struct {
+	Name     string // the name inserted
+	Existing string // the spelling already in the map
+	Key      string // the canonical key they share
+}
+
+// Error implements error
+func (e *AmbiguousKeyError) Error() string {
+	return fmt.Sprintf("%q is ambiguous with %q (both are %q)", e.Name, e.Existing, e.Key)
+}
+
+// Map holds values by the canonical Key of their names, remembering the spelling each name was first given with.
+// The zero value is an empty map ready to use
+type Map[V any] struct {
+	entries map[string]mapEntry[V]
+}
+
+// mapEntry is a value in a Map, with its original name
+type mapEntry[V any] struct {
+	name  string
+	value V
+}
+
+// Set stores the value for the name, replacing any value stored with the same spelling.
+// If the map has the name with a different spelling, it's left unchanged and an *AmbiguousKeyError is returned
+func (m *Map[V]) Set(name string, value V) error {
+	key := Key(name)
+	if e, ok := m.entries[key]; ok && e.name != name {
+		return &AmbiguousKeyError{Name: name, Existing: e.name, Key: key}
+	}
+	if m.entries == nil {
+		m.entries = map[string]mapEntry[V]{}
+	}
+	m.entries[key] = mapEntry[V]{name: name, value: value}
+	return nil
+}
+
+// Get returns the value for the name in any spelling, and whether there was one
+func (m *Map[V]) Get(name string) (V, bool) {
+	e, ok := m.entries[Key(name)]
+	return e.value, ok
+}
+
+// Name returns the spelling the name was stored with, and whether it was
+func (m *Map[V]) Name(name string) (string, bool) {
+	e, ok := m.entries[Key(name)]
+	return e.name, ok
+}
+
+// Delete removes the name in any spelling
+func (m *Map[V]) Delete(name string) {
+	delete(m.entries, Key(name))
+}
+
+// Len returns the number of names in the map
+func (m *Map[V]) Len() int {
+	return len(m.entries)
+}
+
+// Names returns the names in the map as they were stored, sorted
+func (m *Map[V]) Names() []string {
+	ret := make([]string, 0, len(m.entries))
+	for _, e := range m.entries {
+		ret = append(ret, e.name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Range calls fn for each name (as stored) and value, in the order of Names, until it returns false
+func (m *Map[V]) Range(fn func(name string, value V) bool) {
+	for _, name := range m.Names() {
+		if !fn(name, m.entries[Key(name)].value) {
+			return
+		}
+	}
+}
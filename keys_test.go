@@ -0,0 +1,138 @@
+package wordcase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestKey provides unit test coverage for Key()
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "empty", s: "", want: ""},
+		{name: "punctuation only", s: "__-.", want: ""},
+		{name: "snake", s: "user_id", want: "user_id"},
+		{name: "camel", s: "userId", want: "user_id"},
+		{name: "pascal initialism", s: "UserID", want: "user_id"},
+		{name: "screaming kebab", s: "USER-ID", want: "user_id"},
+		{name: "plural initialism", s: "UserIDs", want: "user_ids"},
+		{name: "plural snake", s: "user_ids", want: "user_ids"},
+		{name: "repeated separators", s: "__user..id  ", want: "user_id"},
+		{name: "digits", s: "base64Encode", want: "base64_encode"},
+		{name: "case folding", s: "ſelect", want: "select"},
+		{name: "initialism before a separated word", s: "CPU Is Busy", want: "cpu_is_busy"},
+		{name: "single letters across a separator", s: "A Bs", want: "a_bs"},
+		{name: "initialism before an underscore", s: "ID_As", want: "id_as"},
+		{name: "plural initialism after a separator", s: "all_URLs", want: "all_urls"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Key(tt.s)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, got, Key(got), "a key is its own key")
+		})
+	}
+}
+
+// TestKey_styles checks the documented guarantee that converting to a style doesn't change the key
+func TestKey_styles(t *testing.T) {
+	pluralSafe := []Combiner{
+		PluralSafeSnakeCase, PluralSafeKebabCase, PluralSafeDotCase,
+		PluralSafeCamelCase, PluralSafePascalCase, PluralSafeScreamingSnakeCase,
+	}
+	for _, s := range []string{
+		"user id", "HTTP Server", "the quick brown fox", "userIDs", "XMLParser", "all URLs",
+		"UTF8Decoder", "user_ID2", "HTTP2Server", "base64Encode",
+	} {
+		for _, style := range pluralSafe {
+			assert.Equal(t, Key(s), Key(style(s)), "%q as %q", s, style(s))
+		}
+	}
+
+	plain := []Combiner{SnakeCase, KebabCase, DotCase, CamelCase, PascalCase, ScreamingSnakeCase}
+	for _, s := range []string{"user id", "HTTP Server", "the quick brown fox", "userID", "XMLParser", "base64Encode"} {
+		for _, style := range plain {
+			assert.Equal(t, Key(s), Key(style(s)), "%q as %q", s, style(s))
+		}
+	}
+
+	// the plain styles split upper case letters from a digit after them, which Key doesn't
+	for s, want := range map[string]string{"UTF8Decoder": "ut_f8_decoder", "user_ID2": "user_i_d2"} {
+		assert.NotEqual(t, Key(s), want)
+		assert.Equal(t, want, Key(SnakeCase(s)), "%q as %q", s, SnakeCase(s))
+	}
+
+	// pascal case runs an initialism into the next word, so these only keep their key in the other styles
+	apart := append(plain[:4:4], pluralSafe[:4]...)
+	for _, s := range []string{"CPU Is Busy", "ID_As", "A Bs"} {
+		for _, style := range apart {
+			assert.Equal(t, Key(s), Key(style(s)), "%q as %q", s, style(s))
+		}
+	}
+}
+
+// TestEqual provides unit test coverage for Equal()
+func TestEqual(t *testing.T) {
+	assert.True(t, Equal("user_id", "userId"))
+	assert.True(t, Equal("UserID", "USER-ID"))
+	assert.True(t, Equal("", "--"))
+	assert.False(t, Equal("user_id", "userid"))
+	assert.False(t, Equal("user_id", "user_ids"))
+	assert.False(t, Equal("A Bs", "abs"), "words are kept apart at separators")
+	assert.True(t, Equal("CPU Is Busy", CamelCase("CPU Is Busy")))
+	assert.False(t, Equal("XmlHttpRequest", PascalCase("XmlHttpRequest")), "initialisms run together")
+}
+
+// TestMap provides unit test coverage for Map
+func TestMap(t *testing.T) {
+	var m Map[int]
+	assert.Equal(t, 0, m.Len())
+	_, ok := m.Get("anything")
+	assert.False(t, ok)
+
+	assert.NoError(t, m.Set("userID", 1))
+	assert.NoError(t, m.Set("max_size", 2))
+	assert.NoError(t, m.Set("userID", 3), "the same spelling replaces the value")
+
+	err := m.Set("user_id", 4)
+	assert.Equal(t, &AmbiguousKeyError{Name: "user_id", Existing: "userID", Key: "user_id"}, err)
+	assert.EqualError(t, err, `"user_id" is ambiguous with "userID" (both are "user_id")`)
+
+	v, ok := m.Get("USER-ID")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	name, ok := m.Name("user_id")
+	assert.True(t, ok)
+	assert.Equal(t, "userID", name)
+	_, ok = m.Name("missing")
+	assert.False(t, ok)
+
+	assert.Equal(t, 2, m.Len())
+	assert.Equal(t, []string{"max_size", "userID"}, m.Names())
+
+	var seen []string
+	m.Range(func(name string, value int) bool {
+		seen = append(seen, name)
+		return true
+	})
+	assert.Equal(t, []string{"max_size", "userID"}, seen)
+
+	seen = nil
+	m.Range(func(name string, value int) bool {
+		seen = append(seen, name)
+		return false
+	})
+	assert.Equal(t, []string{"max_size"}, seen)
+
+	m.Delete("MaxSize")
+	assert.Equal(t, []string{"userID"}, m.Names())
+	assert.NoError(t, m.Set("MaxSize", 5), "a deleted name can be set in a new spelling")
+}
@@ -0,0 +1,179 @@
+package wordcase
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// JoinLossless concatenates tokens into a string with the given separator, except where tokenizing the result
+// again with Tokenizer wouldn't give back the same tokens, in which case alt is used instead.
+//
+//	alt should be something Tokenizer always splits on (eg "_"), so that the boundary survives.
+//	Only the two tokens either side of each boundary are checked, as Tokenizer's decisions depend on neighbouring runes
+func (t Tokens) JoinLossless(sep, alt string) string {
+	var b strings.Builder
+	for i, x := range t {
+		if i > 0 {
+			if tokensEqual(Tokenizer(t[i-1]+sep+x), t[i-1:i+1]) {
+				b.WriteString(sep)
+			} else {
+				b.WriteString(alt)
+			}
+		}
+		b.WriteString(x)
+	}
+	return b.String()
+}
+
+// tokensEqual returns true if both token lists hold the same tokens in the same order
+func tokensEqual(a, b Tokens) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// LosslessCamelCase is CamelCase, except that an underscore is placed between tokens whose boundary would otherwise
+// be lost (eg "a_b_c" -> "aB_C", "user_id_2" -> "userID_2"), so the result can be converted back to the same tokens
+var LosslessCamelCase = NewPipeline().
+	TokenizeUsing(LookAroundCategorizer, NotLetterOrDigit, true).
+	TokenizeUsing(CaseChangeCategorizer, NotLowerOrDigit, false).
+	WithAllFormatter(strings.ToLower).
+	WithFormatter(UppercaseFirst, ToRest).
+	WithFormatter(strings.ToUpper, And(ToRest, LintWords)).
+	JoinLossless("", "_")
+
+// LosslessPascalCase is PascalCase, except that an underscore is placed between tokens whose boundary would otherwise
+// be lost (eg "a_b_c" -> "A_B_C", "xml_http" -> "XML_HTTP"), so the result can be converted back to the same tokens
+var LosslessPascalCase = NewPipeline().
+	TokenizeUsing(LookAroundCategorizer, NotLetterOrDigit, true).
+	TokenizeUsing(CaseChangeCategorizer, NotLowerOrDigit, false).
+	WithAllFormatter(strings.ToLower).
+	WithAllFormatter(UppercaseFirst).
+	WithFormatter(strings.ToUpper, LintWords).
+	JoinLossless("", "_")
+
+// LossKind categorises information lost in a conversion
+type LossKind int
+
+const (
+	// LostText means characters were dropped or changed, beyond their case
+	LostText LossKind = iota
+	// LostBoundary means the boundary between two tokens was lost, joining them together
+	LostBoundary
+	// AddedBoundary means a token was split in two
+	AddedBoundary
+	// LostCase means the case of a token was changed
+	LostCase
+	// MovedBoundary means the boundary between two tokens was moved, eg "id", "2" became "i", "d2"
+	MovedBoundary
+)
+
+// String returns a description of the kind of loss
+func (k LossKind) String() string {
+	switch k {
+	case LostText:
+		return "text changed"
+	case LostBoundary:
+		return "boundary lost"
+	case AddedBoundary:
+		return "boundary added"
+	case LostCase:
+		return "case changed"
+	case MovedBoundary:
+		return "boundary moved"
+	}
+	return fmt.Sprintf("LossKind(%d)", int(k))
+}
+
+// Loss describes a piece of information lost in a conversion
+type Loss struct {
+	Kind LossKind
+	Want Tokens // the tokens before the round trip
+	Got  Tokens // the tokens after the round trip
+}
+
+// String returns a description of the loss
+func (l Loss) String() string {
+	return fmt.Sprintf("%s: %q became %q", l.Kind, []string(l.Want), []string(l.Got))
+}
+
+// RoundTrip is the result of converting a string with one Combiner, then another, then back with the first
+type RoundTrip struct {
+	Input  string // the original string
+	From   string // the input converted with the first Combiner
+	To     string // From converted with the second Combiner
+	Back   string // To converted with the first Combiner again
+	Losses []Loss // information lost between From and Back, in order of occurrence
+}
+
+// Lossless returns true if the round trip gave back the same string
+func (r RoundTrip) Lossless() bool {
+	return r.From == r.Back
+}
+
+// CheckRoundTrip converts s with from, the result with to, and that back with from, reporting whether the conversion
+// round trips and, if it doesn't, what was lost.
+//
+//	eg CheckRoundTrip("user_id_2", SnakeCase, CamelCase) reports that the boundary between "id" and "2" was lost,
+//	whereas CheckRoundTrip("user_id_2", SnakeCase, LosslessCamelCase) round trips
+func CheckRoundTrip(s string, from, to Combiner) RoundTrip {
+	r := RoundTrip{Input: s}
+	r.From = from(s)
+	r.To = to(r.From)
+	r.Back = from(r.To)
+	if !r.Lossless() {
+		r.Losses = compareTokens(Tokenizer(r.From), Tokenizer(r.Back))
+	}
+	return r
+}
+
+// compareTokens describes the differences between two token lists
+func compareTokens(want, got Tokens) []Loss {
+	if !strings.EqualFold(strings.Join(want, ""), strings.Join(got, "")) {
+		return []Loss{{Kind: LostText, Want: want, Got: got}}
+	}
+
+	var ret []Loss
+	ws, gs := tokenStarts(want), tokenStarts(got)
+	wi, gi := 0, 0
+	for wi < len(want) && gi < len(got) {
+		// find the shortest run of tokens in each list that cover the same text
+		wj, gj := wi+1, gi+1
+		for ws[wj] != gs[gj] {
+			if ws[wj] < gs[gj] {
+				wj++
+			} else {
+				gj++
+			}
+		}
+		w, g := want[wi:wj], got[gi:gj]
+		switch {
+		case len(w) > len(g):
+			ret = append(ret, Loss{Kind: LostBoundary, Want: w, Got: g})
+		case len(w) < len(g):
+			ret = append(ret, Loss{Kind: AddedBoundary, Want: w, Got: g})
+		case len(w) > 1:
+			ret = append(ret, Loss{Kind: MovedBoundary, Want: w, Got: g})
+		case !tokensEqual(w, g):
+			ret = append(ret, Loss{Kind: LostCase, Want: w, Got: g})
+		}
+		wi, gi = wj, gj
+	}
+	return ret
+}
+
+// tokenStarts returns the rune offset of the start of each token, along with the offset of the end of the last
+func tokenStarts(t Tokens) []int {
+	ret := make([]int, len(t)+1)
+	for i, x := range t {
+		ret[i+1] = ret[i] + utf8.RuneCountInString(x)
+	}
+	return ret
+}
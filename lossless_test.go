@@ -0,0 +1,177 @@
+package wordcase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTokens_JoinLossless provides unit test coverage for Tokens.JoinLossless()
+func TestTokens_JoinLossless(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Tokens
+		want string
+	}{
+		{
+			name: "empty",
+			t:    Tokens{},
+			want: "",
+		},
+		{
+			name: "one",
+			t:    Tokens{"one"},
+			want: "one",
+		},
+		{
+			name: "no ambiguity",
+			t:    Tokens{"one", "Two", "Three"},
+			want: "oneTwoThree",
+		},
+		{
+			name: "single letters",
+			t:    Tokens{"a", "B", "C"},
+			want: "aB_C",
+		},
+		{
+			name: "initialisms",
+			t:    Tokens{"XML", "HTTP", "Request"},
+			want: "XML_HTTPRequest",
+		},
+		{
+			name: "trailing digits",
+			t:    Tokens{"user", "ID", "2"},
+			want: "userID_2",
+		},
+		{
+			name: "leading digits",
+			t:    Tokens{"2", "Fa"},
+			want: "2Fa",
+		},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.t.JoinLossless("", "_")
+			assert.Equal(t, tt.want, got)
+			if len(tt.t) > 0 {
+				assert.Equal(t, tt.t, Tokenizer(got))
+			}
+		})
+	}
+}
+
+// TestLossKind_String provides unit test coverage for LossKind.String()
+func TestLossKind_String(t *testing.T) {
+	tests := []struct {
+		k    LossKind
+		want string
+	}{
+		{k: LostText, want: "text changed"},
+		{k: LostBoundary, want: "boundary lost"},
+		{k: AddedBoundary, want: "boundary added"},
+		{k: LostCase, want: "case changed"},
+		{k: MovedBoundary, want: "boundary moved"},
+		{k: LossKind(99), want: "LossKind(99)"},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.k.String())
+		})
+	}
+}
+
+// TestCheckRoundTrip provides unit test coverage for CheckRoundTrip()
+func TestCheckRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		s        string
+		from     Combiner
+		to       Combiner
+		wantBack string
+		want     []Loss
+	}{
+		{
+			name:     "snake camel snake",
+			s:        "user_id_2",
+			from:     SnakeCase,
+			to:       CamelCase,
+			wantBack: "user_i_d2",
+			want:     []Loss{{Kind: MovedBoundary, Want: Tokens{"id", "2"}, Got: Tokens{"i", "d2"}}},
+		},
+		{
+			name:     "snake lossless camel snake",
+			s:        "user_id_2",
+			from:     SnakeCase,
+			to:       LosslessCamelCase,
+			wantBack: "user_id_2",
+		},
+		{
+			name:     "single letters",
+			s:        "a_b_c",
+			from:     SnakeCase,
+			to:       CamelCase,
+			wantBack: "a_bc",
+			want:     []Loss{{Kind: LostBoundary, Want: Tokens{"b", "c"}, Got: Tokens{"bc"}}},
+		},
+		{
+			name:     "single letters lossless",
+			s:        "a_b_c",
+			from:     SnakeCase,
+			to:       LosslessPascalCase,
+			wantBack: "a_b_c",
+		},
+		{
+			name:     "initialisms",
+			s:        "XMLHttp",
+			from:     Words,
+			to:       PascalCase,
+			wantBack: "XMLHTTP",
+			want:     []Loss{{Kind: LostBoundary, Want: Tokens{"XML", "HTTP"}, Got: Tokens{"XMLHTTP"}}},
+		},
+		{
+			name:     "case",
+			s:        "dooker Spam99 rawr",
+			from:     Words,
+			to:       SnakeCase,
+			wantBack: "dooker spam99 rawr",
+			want:     []Loss{{Kind: LostCase, Want: Tokens{"Spam99"}, Got: Tokens{"spam99"}}},
+		},
+		{
+			name:     "added boundary",
+			s:        "ab",
+			from:     Words,
+			to:       func(s string) string { return s[:1] + "_" + s[1:] },
+			wantBack: "a b",
+			want:     []Loss{{Kind: AddedBoundary, Want: Tokens{"ab"}, Got: Tokens{"a", "b"}}},
+		},
+		{
+			name:     "text",
+			s:        "ab",
+			from:     Words,
+			to:       func(s string) string { return s + "c" },
+			wantBack: "abc",
+			want:     []Loss{{Kind: LostText, Want: Tokens{"ab"}, Got: Tokens{"abc"}}},
+		},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := CheckRoundTrip(tt.s, tt.from, tt.to)
+			assert.Equal(t, tt.s, got.Input)
+			assert.Equal(t, tt.wantBack, got.Back)
+			assert.Equal(t, tt.want == nil, got.Lossless())
+			assert.Equal(t, tt.want, got.Losses)
+		})
+	}
+}
+
+// TestLoss_String provides unit test coverage for Loss.String()
+func TestLoss_String(t *testing.T) {
+	l := Loss{Kind: LostBoundary, Want: Tokens{"id", "2"}, Got: Tokens{"id2"}}
+	assert.Equal(t, `boundary lost: ["id" "2"] became ["id2"]`, l.String())
+}
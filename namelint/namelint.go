@@ -0,0 +1,429 @@
+// Package namelint checks that the names used in Go source follow configured case styles
+package namelint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mantidtech/wordcase"
+)
+
+// The kinds of name that can be checked
+const (
+	KindFunc   = "func"   // function names
+	KindMethod = "method" // method names, including interface methods
+	KindType   = "type"   // type names
+	KindConst  = "const"  // constant names
+	KindVar    = "var"    // variable names, at any level
+	KindField  = "field"  // struct field names
+	KindParam  = "param"  // function parameter and result names
+	KindTag    = "tag"    // struct tag names, selected as tag.<key> (eg "tag.json")
+	KindValue  = "value"  // string constant values, selected as value.<type> for a given type (eg "value.Status")
+)
+
+// Rule requires names matching the selector to be in the given style.
+//
+//	A selector is a kind, optionally qualified by "exported." or "unexported." for identifiers (eg "exported.func"),
+//	or by the tag key or constant type for tags and values (eg "tag.json", "value.Status").
+//	The qualifiers alone ("exported", "unexported") select identifiers of any kind
+type Rule struct {
+	Selector string
+	Style    string
+	convert  wordcase.Combiner
+}
+
+// ParseRule parses a rule written as selector=style, eg "tag.json=snake"
+func ParseRule(s string) (Rule, error) {
+	sel, style, ok := strings.Cut(s, "=")
+	if !ok || sel == "" || style == "" {
+		return Rule{}, fmt.Errorf("rule %q should be written as selector=style", s)
+	}
+	return NewRule(strings.TrimSpace(sel), strings.TrimSpace(style))
+}
+
+// NewRule creates a rule requiring names matching the selector to be in the named style (see wordcase.StyleByName),
+// or following GoLintStyle
+func NewRule(selector, style string) (Rule, error) {
+	if style == GoLintStyle {
+		return Rule{Selector: selector, Style: style, convert: goLint}, nil
+	}
+	c, ok := wordcase.StyleByName(style)
+	if !ok {
+		names := append(wordcase.StyleNames(), GoLintStyle)
+		return Rule{}, fmt.Errorf("unknown style %q, expected one of: %s", style, strings.Join(names, ", "))
+	}
+	return Rule{Selector: selector, Style: style, convert: c}, nil
+}
+
+// GoLintStyle is the name of the style that only checks what golint does: that identifiers have no underscores, and
+// that initialisms from wordcase.GoLintKeywords are same-cased (eg "userId" -> "userID").
+// Other runs of capitals are left alone, so "WriteSARIF", "OSEnvironment" and "DB" are all accepted
+const GoLintStyle = "go-lint"
+
+// DefaultRules are the rules used when none are given: the Go naming conventions checked by golint for all identifiers
+var DefaultRules = []Rule{
+	{Selector: "exported", Style: GoLintStyle, convert: goLint},
+	{Selector: "unexported", Style: GoLintStyle, convert: goLint},
+}
+
+// lintKeywords are wordcase.GoLintKeywords, for looking up
+var lintKeywords = func() map[string]bool {
+	m := make(map[string]bool, len(wordcase.GoLintKeywords))
+	for _, k := range wordcase.GoLintKeywords {
+		m[k] = true
+	}
+	return m
+}()
+
+// goLint converts a Go identifier to follow GoLintStyle, changing it as little as possible.
+//
+//	Underscores between words are removed and the following word capitalised (eg "max_conns" -> "maxConns"),
+//	except in names without any lowercase letters, which are converted in full (eg "MAX_SIZE" -> "MaxSize").
+//	Leading and trailing underscores are kept
+func goLint(name string) string {
+	core := strings.Trim(name, "_")
+	if core == "" {
+		return name
+	}
+	lead := name[:strings.Index(name, core)]
+	trail := name[len(lead)+len(core):]
+
+	if strings.Contains(core, "_") {
+		if strings.ToUpper(core) == core {
+			if ast.IsExported(core) {
+				return lead + wordcase.GoExported(core) + trail
+			}
+			return lead + wordcase.GoUnexported(core) + trail
+		}
+		parts := strings.FieldsFunc(core, func(r rune) bool { return r == '_' })
+		for i := 1; i < len(parts); i++ {
+			parts[i] = wordcase.UppercaseFirst(parts[i])
+		}
+		core = strings.Join(parts, "")
+	}
+
+	var b strings.Builder
+	for _, t := range wordcase.Tokenizer(core) {
+		if l := strings.ToLower(t); lintKeywords[l] && t != l {
+			t = strings.ToUpper(t)
+		}
+		b.WriteString(t)
+	}
+	return lead + b.String() + trail
+}
+
+// Report describes a name that doesn't follow its rule
+type Report struct {
+	Pos  token.Position `json:"pos"`
+	Kind string         `json:"kind"`
+	Name string         `json:"name"`
+	Want string         `json:"want"`
+	Rule string         `json:"rule"`
+}
+
+// String describes the report in the format used by the go tools
+func (r Report) String() string {
+	return fmt.Sprintf("%s: %s %q should be %q (%s)", r.Pos, r.Kind, r.Name, r.Want, r.Rule)
+}
+
+// Checker checks names against a set of rules
+type Checker struct {
+	rules map[string]Rule
+}
+
+// NewChecker creates a checker for the given rules; for rules with the same selector, the last wins
+func NewChecker(rules []Rule) *Checker {
+	c := &Checker{rules: make(map[string]Rule)}
+	for _, r := range rules {
+		c.rules[r.Selector] = r
+	}
+	return c
+}
+
+// ruleFor finds the most specific rule for an identifier of the given kind
+func (c *Checker) ruleFor(kind, name string) (Rule, bool) {
+	q := "unexported"
+	if ast.IsExported(name) {
+		q = "exported"
+	}
+	for _, sel := range []string{q + "." + kind, kind, q} {
+		if r, ok := c.rules[sel]; ok {
+			return r, true
+		}
+	}
+	return Rule{}, false
+}
+
+// checker accumulates the reports for a file
+type checker struct {
+	*Checker
+	fset    *token.FileSet
+	test    bool
+	reports []Report
+}
+
+// check reports the name if it doesn't follow the rule
+func (c *checker) check(pos token.Pos, kind, name string, r Rule) {
+	if want := r.convert(name); want != name {
+		c.reports = append(c.reports, Report{
+			Pos:  c.fset.Position(pos),
+			Kind: kind,
+			Name: name,
+			Want: want,
+			Rule: r.Selector + "=" + r.Style,
+		})
+	}
+}
+
+// ident checks the name of an identifier
+func (c *checker) ident(id *ast.Ident, kind string) {
+	if id == nil || id.Name == "_" {
+		return
+	}
+	if r, ok := c.ruleFor(kind, id.Name); ok {
+		c.check(id.Pos(), kind, id.Name, r)
+	}
+}
+
+// CheckFile checks the names declared in the given file
+func (c *Checker) CheckFile(fset *token.FileSet, f *ast.File) []Report {
+	name := fset.Position(f.Pos()).Filename
+	fc := &checker{Checker: c, fset: fset, test: strings.HasSuffix(name, "_test.go")}
+	ast.Inspect(f, fc.visit)
+	return fc.reports
+}
+
+// visit checks the names declared by a node
+func (c *checker) visit(n ast.Node) bool {
+	switch x := n.(type) {
+	case *ast.FuncDecl:
+		c.funcDecl(x)
+	case *ast.FuncLit:
+		c.fields(x.Type.Params, KindParam)
+		c.fields(x.Type.Results, KindParam)
+	case *ast.GenDecl:
+		c.genDecl(x)
+	case *ast.AssignStmt:
+		if x.Tok == token.DEFINE {
+			for _, e := range x.Lhs {
+				if id, ok := e.(*ast.Ident); ok {
+					c.ident(id, KindVar)
+				}
+			}
+		}
+	case *ast.RangeStmt:
+		if x.Tok == token.DEFINE {
+			for _, e := range []ast.Expr{x.Key, x.Value} {
+				if id, ok := e.(*ast.Ident); ok {
+					c.ident(id, KindVar)
+				}
+			}
+		}
+	case *ast.StructType:
+		c.structType(x)
+	case *ast.InterfaceType:
+		for _, m := range x.Methods.List {
+			for _, id := range m.Names {
+				c.ident(id, KindMethod)
+			}
+		}
+	}
+	return true
+}
+
+// funcDecl checks a function or method declaration
+func (c *checker) funcDecl(fd *ast.FuncDecl) {
+	name := fd.Name.Name
+	switch {
+	case fd.Recv != nil:
+		c.ident(fd.Name, KindMethod)
+	case fd.Name.Name == "init" || fd.Name.Name == "main":
+	case c.test && isTestFunc(name):
+	default:
+		c.ident(fd.Name, KindFunc)
+	}
+	c.fields(fd.Type.Params, KindParam)
+	c.fields(fd.Type.Results, KindParam)
+}
+
+// isTestFunc returns true for the names of functions run by go test, which use underscores by convention
+func isTestFunc(name string) bool {
+	for _, p := range []string{"Test", "Benchmark", "Example", "Fuzz"} {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// fields checks the names in a field list
+func (c *checker) fields(fl *ast.FieldList, kind string) {
+	if fl == nil {
+		return
+	}
+	for _, f := range fl.List {
+		for _, id := range f.Names {
+			c.ident(id, kind)
+		}
+	}
+}
+
+// genDecl checks type, const and var declarations
+func (c *checker) genDecl(gd *ast.GenDecl) {
+	var typ ast.Expr
+	for _, spec := range gd.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			c.ident(s.Name, KindType)
+		case *ast.ValueSpec:
+			kind := KindVar
+			if gd.Tok == token.CONST {
+				kind = KindConst
+				if s.Type != nil || len(s.Values) > 0 {
+					typ = s.Type // an implicit repetition of the previous spec keeps its type
+				}
+				c.values(s, typ)
+			}
+			for _, id := range s.Names {
+				c.ident(id, kind)
+			}
+		}
+	}
+}
+
+// values checks the string values of a constant declaration
+func (c *checker) values(s *ast.ValueSpec, typ ast.Expr) {
+	sel := KindValue
+	if id, ok := typ.(*ast.Ident); ok {
+		sel = KindValue + "." + id.Name
+	}
+	r, ok := c.rules[sel]
+	if !ok {
+		if r, ok = c.rules[KindValue]; !ok {
+			return
+		}
+	}
+	for _, v := range s.Values {
+		lit, ok := v.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+		if str, err := strconv.Unquote(lit.Value); err == nil && str != "" {
+			c.check(lit.Pos(), sel, str, r)
+		}
+	}
+}
+
+// structType checks the field names and tags of a struct
+func (c *checker) structType(st *ast.StructType) {
+	for _, f := range st.Fields.List {
+		for _, id := range f.Names {
+			c.ident(id, KindField)
+		}
+		if f.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			continue
+		}
+		c.tags(f.Tag.Pos(), reflect.StructTag(tag))
+	}
+}
+
+// tags checks the names given in a struct tag, in the order their keys appear in the tag
+func (c *checker) tags(pos token.Pos, tag reflect.StructTag) {
+	var sels []string
+	for sel := range c.rules {
+		key, ok := strings.CutPrefix(sel, KindTag+".")
+		if !ok {
+			continue
+		}
+		if _, ok := tag.Lookup(key); ok {
+			sels = append(sels, sel)
+		}
+	}
+	sort.Slice(sels, func(i, j int) bool {
+		return tagKeyOffset(tag, sels[i]) < tagKeyOffset(tag, sels[j])
+	})
+
+	for _, sel := range sels {
+		v, _ := tag.Lookup(strings.TrimPrefix(sel, KindTag+"."))
+		name, _, _ := strings.Cut(v, ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		c.check(pos, sel, name, c.rules[sel])
+	}
+}
+
+// tagKeyOffset returns where the key of a tag selector appears in the tag
+func tagKeyOffset(tag reflect.StructTag, sel string) int {
+	key := strings.TrimPrefix(sel, KindTag+".") + `:"`
+	s := string(tag)
+	for i := 0; ; {
+		n := strings.Index(s[i:], key)
+		if n < 0 {
+			return len(s)
+		}
+		i += n
+		if i == 0 || s[i-1] == ' ' {
+			return i
+		}
+		i++
+	}
+}
+
+// CheckPath parses and checks the Go files in the given directory.
+// If the path ends in "/...", subdirectories are checked too, except vendor, testdata and hidden directories
+func (c *Checker) CheckPath(path string) ([]Report, error) {
+	root, recurse := strings.CutSuffix(path, "/...")
+	if root == "" {
+		root = "."
+	}
+
+	var reports []Report
+	err := filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != root && (!recurse || skipDir(d.Name())) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(p, ".go") {
+			return nil
+		}
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, p, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		reports = append(reports, c.CheckFile(fset, f)...)
+		return nil
+	})
+
+	sort.SliceStable(reports, func(i, j int) bool {
+		a, b := reports[i].Pos, reports[j].Pos
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Offset < b.Offset
+	})
+	return reports, err
+}
+
+// skipDir returns true for directories that aren't checked when recursing
+func skipDir(name string) bool {
+	return name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
@@ -0,0 +1,270 @@
+package namelint
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// helperParseRules parses rules, failing the test on error
+func helperParseRules(t *testing.T, rules ...string) []Rule {
+	t.Helper()
+	ret := make([]Rule, 0, len(rules))
+	for _, s := range rules {
+		r, err := ParseRule(s)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		ret = append(ret, r)
+	}
+	return ret
+}
+
+// helperCheck checks the source with the rules, returning the name and suggestion from each report
+func helperCheck(t *testing.T, filename, src string, rules []Rule) [][2]string {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	var ret [][2]string
+	for _, r := range NewChecker(rules).CheckFile(fset, f) {
+		ret = append(ret, [2]string{r.Name, r.Want})
+	}
+	return ret
+}
+
+// TestParseRule provides unit test coverage for ParseRule()
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    Rule
+		wantErr bool
+	}{
+		{
+			name: "simple",
+			rule: "tag.json=snake",
+			want: Rule{Selector: "tag.json", Style: "snake"},
+		},
+		{
+			name: "style in any case",
+			rule: "tag.env = SCREAMING_SNAKE_CASE",
+			want: Rule{Selector: "tag.env", Style: "SCREAMING_SNAKE_CASE"},
+		},
+		{
+			name:    "no style",
+			rule:    "tag.json",
+			wantErr: true,
+		},
+		{
+			name: "go lint",
+			rule: "exported=go-lint",
+			want: Rule{Selector: "exported", Style: "go-lint"},
+		},
+		{
+			name:    "unknown style",
+			rule:    "func=sponge",
+			wantErr: true,
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseRule(tt.rule)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.Selector, got.Selector)
+			assert.Equal(t, tt.want.Style, got.Style)
+			assert.NotNil(t, got.convert)
+		})
+	}
+}
+
+// TestCheckerCheckFile provides unit test coverage for Checker.CheckFile()
+func TestCheckerCheckFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		src      string
+		rules    []string
+		want     [][2]string
+	}{
+		{
+			name: "default rules",
+			src: `package p
+type user_record struct{ User_Id int; name string }
+func (u user_record) Get_Name() string { var full_name = u.name; return full_name }
+func ServeHttp(http_req int) (result_code int) { for i, the_val := range []int{} { _, _ = i, the_val }; return }
+const Max_Size = 10
+func init() {}
+func main() {}
+`,
+			want: [][2]string{
+				{"user_record", "userRecord"},
+				{"User_Id", "UserID"},
+				{"Get_Name", "GetName"},
+				{"full_name", "fullName"},
+				{"ServeHttp", "ServeHTTP"},
+				{"http_req", "httpReq"},
+				{"result_code", "resultCode"},
+				{"the_val", "theVal"},
+				{"Max_Size", "MaxSize"},
+			},
+		},
+		{
+			name: "default rules allow initialisms",
+			src: `package p
+func WriteSARIF() {}
+type OSEnvironment struct{ DB int; wantOK bool; userId int; xmlHttpRequest string }
+const SQLite = 1
+const MAX_SIZE = 2
+var _private_cache, lower_ int
+`,
+			want: [][2]string{
+				{"userId", "userID"},
+				{"xmlHttpRequest", "xmlHTTPRequest"},
+				{"MAX_SIZE", "MaxSize"},
+				{"_private_cache", "_privateCache"},
+			},
+		},
+		{
+			name:     "test functions",
+			filename: "p_test.go",
+			src: `package p
+func TestThing_Works(t int) {}
+func Example_usage() {}
+func helper_func() {}
+`,
+			want: [][2]string{{"helper_func", "helperFunc"}},
+		},
+		{
+			name:  "specific selector wins",
+			src:   "package p\nconst MAX_SIZE = 1\nvar Max_Size = 1\n",
+			rules: []string{"exported=go-exported", "exported.const=screaming-snake"},
+			want:  [][2]string{{"Max_Size", "MaxSize"}},
+		},
+		{
+			name:  "kind selector",
+			src:   "package p\nfunc f(user_id int) {}\ntype t interface{ do_it() }\n",
+			rules: []string{"method=camel"},
+			want:  [][2]string{{"do_it", "doIt"}},
+		},
+		{
+			name: "struct tags",
+			src: "package p\ntype T struct {\n" +
+				"\tA int `json:\"userId,omitempty\" env:\"user_id\"`\n" +
+				"\tB int `json:\"-\" env:\"USER_NAME\"`\n" +
+				"\tC int `json:\",omitempty\"`\n" +
+				"\tD int `json:\"user_name\"`\n}\n",
+			rules: []string{"tag.json=snake", "tag.env=screaming-snake"},
+			want: [][2]string{
+				{"userId", "user_id"},
+				{"user_id", "USER_ID"},
+			},
+		},
+		{
+			name: "struct tags in tag order",
+			src: "package p\ntype T struct {\n" +
+				"\tA int `yaml:\"UserName\" json:\"userId\" env:\"user_id\" xml:\"User-Id\"`\n}\n",
+			rules: []string{"tag.env=screaming-snake", "tag.json=snake", "tag.xml=camel", "tag.yaml=kebab"},
+			want: [][2]string{
+				{"UserName", "user-name"},
+				{"userId", "user_id"},
+				{"user_id", "USER_ID"},
+				{"User-Id", "userID"},
+			},
+		},
+		{
+			name: "const values",
+			src: `package p
+type Status string
+const (
+	Active Status = "active"
+	Retired Status = "RetiredUser"
+	Other          = "OtherThing"
+)
+const Name = "someName"
+const Count = 3
+`,
+			rules: []string{"value.Status=snake", "value=kebab"},
+			want: [][2]string{
+				{"RetiredUser", "retired_user"},
+				{"OtherThing", "other-thing"},
+				{"someName", "some-name"},
+			},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rules := DefaultRules
+			if tt.rules != nil {
+				rules = helperParseRules(t, tt.rules...)
+			}
+			filename := tt.filename
+			if filename == "" {
+				filename = "p.go"
+			}
+			got := helperCheck(t, filename, tt.src, rules)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestCheckerCheckPath provides unit test coverage for Checker.CheckPath()
+func TestCheckerCheckPath(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.go":             "package p\nvar Bad_Name = 1\n",
+		"readme.txt":       "Not_Go",
+		"sub/b.go":         "package sub\nvar other_name = 1\n",
+		"vendor/v/v.go":    "package v\nvar Vendored_Name = 1\n",
+		"testdata/t/t.go":  "package t\nvar Test_Data = 1\n",
+		".hidden/h/h.go":   "package h\nvar Hidden_Name = 1\n",
+		"broken/broken.go": "package",
+	}
+	for name, src := range files {
+		p := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(p), 0o700))
+		assert.NoError(t, os.WriteFile(p, []byte(src), 0o600))
+	}
+	assert.NoError(t, os.Remove(filepath.Join(dir, "broken/broken.go")))
+
+	c := NewChecker(DefaultRules)
+
+	got, err := c.CheckPath(dir)
+	assert.NoError(t, err)
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, "Bad_Name", got[0].Name)
+		assert.Equal(t, 2, got[0].Pos.Line)
+		assert.Equal(t, filepath.Join(dir, "a.go"), got[0].Pos.Filename)
+	}
+
+	got, err = c.CheckPath(dir + "/...")
+	assert.NoError(t, err)
+	var names []string
+	for _, r := range got {
+		names = append(names, r.Name)
+	}
+	assert.Equal(t, []string{"Bad_Name", "other_name"}, names)
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "broken/broken.go"), []byte("package"), 0o600))
+	_, err = c.CheckPath(dir + "/...")
+	assert.Error(t, err)
+
+	_, err = c.CheckPath(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
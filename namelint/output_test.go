@@ -0,0 +1,80 @@
+package namelint
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testReports = []Report{
+	{
+		Pos:  token.Position{Filename: "p.go", Offset: 20, Line: 2, Column: 5},
+		Kind: "func",
+		Name: "Serve_Http",
+		Want: "ServeHTTP",
+		Rule: "exported=go-exported",
+	},
+	{
+		Pos:  token.Position{Filename: "p.go", Offset: 40, Line: 3, Column: 8},
+		Kind: "tag.json",
+		Name: "userId",
+		Want: "user_id",
+		Rule: "tag.json=snake",
+	},
+}
+
+// TestWriteText provides unit test coverage for WriteText()
+func TestWriteText(t *testing.T) {
+	var b bytes.Buffer
+	assert.NoError(t, WriteText(&b, testReports))
+	want := `p.go:2:5: func "Serve_Http" should be "ServeHTTP" (exported=go-exported)
+p.go:3:8: tag.json "userId" should be "user_id" (tag.json=snake)
+`
+	assert.Equal(t, want, b.String())
+}
+
+// TestWriteJSON provides unit test coverage for WriteJSON()
+func TestWriteJSON(t *testing.T) {
+	var b bytes.Buffer
+	assert.NoError(t, WriteJSON(&b, testReports))
+	var got []Report
+	assert.NoError(t, json.Unmarshal(b.Bytes(), &got))
+	assert.Equal(t, testReports, got)
+
+	b.Reset()
+	assert.NoError(t, WriteJSON(&b, nil))
+	assert.Equal(t, "[]\n", b.String())
+}
+
+// TestWriteSARIF provides unit test coverage for WriteSARIF()
+func TestWriteSARIF(t *testing.T) {
+	var b bytes.Buffer
+	assert.NoError(t, WriteSARIF(&b, testReports))
+
+	var got sarif
+	assert.NoError(t, json.Unmarshal(b.Bytes(), &got))
+	assert.Equal(t, "2.1.0", got.Version)
+	if !assert.Len(t, got.Runs, 1) {
+		t.FailNow()
+	}
+	run := got.Runs[0]
+	assert.Equal(t, "wordcase-lint", run.Tool.Driver.Name)
+	assert.Equal(t, []sarifRule{
+		{ID: "exported=go-exported", ShortDescription: sarifMessage{Text: "names must follow the rule exported=go-exported"}},
+		{ID: "tag.json=snake", ShortDescription: sarifMessage{Text: "names must follow the rule tag.json=snake"}},
+	}, run.Tool.Driver.Rules)
+	if assert.Len(t, run.Results, 2) {
+		r := run.Results[1]
+		assert.Equal(t, "tag.json=snake", r.RuleID)
+		assert.Equal(t, `tag.json "userId" should be "user_id"`, r.Message.Text)
+		assert.Equal(t, "p.go", r.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+		assert.Equal(t, sarifRegion{StartLine: 3, StartColumn: 8}, r.Locations[0].PhysicalLocation.Region)
+	}
+
+	b.Reset()
+	assert.NoError(t, WriteSARIF(&b, nil))
+	assert.Contains(t, b.String(), `"results": []`)
+}
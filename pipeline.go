@@ -1,5 +1,9 @@
 package wordcase
 
+import (
+	"regexp"
+)
+
 // Pipeline defines operations to apply to a string to tokenise it
 type Pipeline func(string) Tokens
 
@@ -23,6 +27,25 @@ func (f Pipeline) TokenizeUsing(test SeparatorTest, sep IsRuneSeparator, del boo
 	}
 }
 
+// TokenizeRegexp creates tokens from the text matched by the given expression.
+//
+//	set keepUnmatched to true to keep the text between matches as tokens, or false to discard it.
+//	See TokenizeStringRegexp for how capturing groups are treated
+func (f Pipeline) TokenizeRegexp(re *regexp.Regexp, keepUnmatched bool) Pipeline {
+	return func(s string) Tokens {
+		return f(s).TokenizeRegexp(re, keepUnmatched)
+	}
+}
+
+// SplitRegexp creates tokens by splitting on text matched by the given expression.
+//
+//	set rmSep to true to delete the separating text, or false to make it part of the next token
+func (f Pipeline) SplitRegexp(re *regexp.Regexp, rmSep bool) Pipeline {
+	return func(s string) Tokens {
+		return f(s).SplitRegexp(re, rmSep)
+	}
+}
+
 // WithFormatter adds a token formatter.
 // The formatter function supplied will be applied to each that the given selector matches
 func (f Pipeline) WithFormatter(formatter Formatter, selector TokenSelector) Pipeline {
@@ -38,9 +61,76 @@ func (f Pipeline) WithAllFormatter(formatter Formatter) Pipeline {
 	}
 }
 
+// Drop adds a stage that removes the tokens the given selector matches
+func (f Pipeline) Drop(selector TokenSelector) Pipeline {
+	return func(s string) Tokens {
+		return f(s).Drop(selector)
+	}
+}
+
+// TokenizeEach adds a stage that breaks each token into more tokens with the given pipeline, eg to apply KeepPlurals
+// to each word on its own
+func (f Pipeline) TokenizeEach(p Pipeline) Pipeline {
+	return func(s string) Tokens {
+		r := Tokens{}
+		for _, x := range f(s) {
+			r = append(r, p(x)...)
+		}
+		return r
+	}
+}
+
+// KeepPlurals adds a stage that rejoins plural initialisms split at their last capital, eg "IDs", "URLs".
+// Tokens are rejoined wherever they're next to each other, so to keep words apart use it on each word with
+// TokenizeEach, as PluralSafeTokenizer does
+func (f Pipeline) KeepPlurals() Pipeline {
+	return func(s string) Tokens {
+		return f(s).KeepPlurals()
+	}
+}
+
+// Transliterate adds a stage that transliterates every token (see Transliterator.Formatter).
+// With ErrorOnUnmappable runes that aren't in any table are dropped; use TransliterateChecked to have them reported
+func (f Pipeline) Transliterate(t Transliterator) Pipeline {
+	return f.WithAllFormatter(t.Formatter())
+}
+
+// TransliterateChecked ends the pipeline with a stage that transliterates every token, returning the
+// *UnmappableError for the first rune that isn't in any table when the mode is ErrorOnUnmappable.
+//
+//	The Offset of the error is the rune's byte offset within its token
+func (f Pipeline) TransliterateChecked(t Transliterator) func(string) (Tokens, error) {
+	return func(s string) (Tokens, error) {
+		r := f(s)
+		for i, x := range r {
+			out, err := t.Transliterate(x)
+			if err != nil {
+				return nil, err
+			}
+			r[i] = out
+		}
+		return r, nil
+	}
+}
+
 // JoinWith generates a function that combines tokens together with the given glue
 func (f Pipeline) JoinWith(sep string) Combiner {
 	return func(s string) string {
 		return f(s).Join(sep)
 	}
 }
+
+// JoinUsing generates a function that combines tokens together, using glue to decide the separator at each boundary
+func (f Pipeline) JoinUsing(glue GlueFn) Combiner {
+	return func(s string) string {
+		return f(s).JoinUsing(glue)
+	}
+}
+
+// JoinLossless generates a function that combines tokens together with the given glue, using alt instead wherever
+// the boundary between tokens would otherwise be lost
+func (f Pipeline) JoinLossless(sep, alt string) Combiner {
+	return func(s string) string {
+		return f(s).JoinLossless(sep, alt)
+	}
+}
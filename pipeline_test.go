@@ -1,6 +1,7 @@
 package wordcase
 
 import (
+	"regexp"
 	"strings"
 	"testing"
 	"unicode"
@@ -85,6 +86,75 @@ func TestPipeline_TokenizeUsing(t *testing.T) {
 	}
 }
 
+func TestPipeline_TokenizeRegexp(t *testing.T) {
+	tests := []struct {
+		name          string
+		re            *regexp.Regexp
+		keepUnmatched bool
+		str           string
+		want          Tokens
+	}{
+		{
+			name: "drop unmatched",
+			re:   regexp.MustCompile(`[a-z]+|\d+`),
+			str:  "user id 2!",
+			want: Tokens{"user", "id", "2"},
+		},
+		{
+			name:          "keep unmatched",
+			re:            regexp.MustCompile(`[a-z]+|\d+`),
+			keepUnmatched: true,
+			str:           "user id 2!",
+			want:          Tokens{"user", " ", "id", " ", "2", "!"},
+		},
+	}
+	pl := NewPipeline()
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := pl.TokenizeRegexp(tt.re, tt.keepUnmatched)
+			assert.Equal(t, tt.want, got(tt.str))
+		})
+	}
+}
+
+func TestPipeline_SplitRegexp(t *testing.T) {
+	tests := []struct {
+		name  string
+		re    *regexp.Regexp
+		rmSep bool
+		str   string
+		want  Tokens
+	}{
+		{
+			name:  "remove separators",
+			re:    regexp.MustCompile(`[-_]+`),
+			rmSep: true,
+			str:   "one-two__three",
+			want:  Tokens{"one", "two", "three"},
+		},
+		{
+			name:  "multi-pass",
+			re:    regexp.MustCompile(`[-_]+`),
+			rmSep: false,
+			str:   "one-two__three",
+			want:  Tokens{"one", "-two", "__three"},
+		},
+	}
+	pl := NewPipeline()
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := pl.SplitRegexp(tt.re, tt.rmSep)
+			assert.Equal(t, tt.want, got(tt.str))
+		})
+	}
+}
+
 func TestPipeline_WithFormatter(t *testing.T) {
 	tests := []struct {
 		name string
@@ -137,6 +207,51 @@ func TestPipeline_WithAllFormatter(t *testing.T) {
 	}
 }
 
+func TestPipeline_Drop(t *testing.T) {
+	pl := NewPipeline().TokenizeUsing(SimpleCategorizer, unicode.IsSpace, true)
+	got := pl.Drop(ToFirst)
+	assert.Equal(t, Tokens{"two", "three"}, got("one two three"))
+}
+
+func TestPipeline_KeepPlurals(t *testing.T) {
+	got := Tokenizer.KeepPlurals()
+	assert.Equal(t, Tokens{"User", "IDs"}, got("UserIDs"))
+}
+
+// TestPipeline_TokenizeEach provides unit test coverage for Pipeline.TokenizeEach()
+func TestPipeline_TokenizeEach(t *testing.T) {
+	words := NewPipeline().TokenizeUsing(SimpleCategorizer, unicode.IsSpace, true)
+	got := words.TokenizeEach(NewPipeline().TokenizeUsing(CaseChangeCategorizer, NotLowerOrDigit, false).KeepPlurals())
+	assert.Equal(t, Tokens{"User", "IDs", "A", "Bs"}, got("UserIDs A Bs"))
+	assert.Equal(t, Tokens{}, got(""))
+}
+
+// TestPipeline_Transliterate provides unit test coverage for Pipeline.Transliterate()
+func TestPipeline_Transliterate(t *testing.T) {
+	ascii := Transliterator{Tables: []TransliterationTable{GermanTable, LatinTable}, Mode: DropUnmappable}
+	got := Tokenizer.WithAllFormatter(strings.ToLower).Transliterate(ascii).JoinWith("_")
+	assert.Equal(t, "aero_strasse_groesse", got("Ærø Straße Größe"))
+}
+
+// TestPipeline_TransliterateChecked provides unit test coverage for Pipeline.TransliterateChecked()
+func TestPipeline_TransliterateChecked(t *testing.T) {
+	strict := Transliterator{Tables: DefaultTransliterationTables, Mode: ErrorOnUnmappable}
+	got := Tokenizer.WithAllFormatter(strings.ToLower).TransliterateChecked(strict)
+
+	tokens, err := got("Ærø Straße")
+	assert.NoError(t, err)
+	assert.Equal(t, Tokens{"aero", "strasse"}, tokens)
+
+	tokens, err = NewPipeline().TransliterateChecked(strict)("user☃name")
+	assert.Equal(t, &UnmappableError{Rune: '☃', Offset: 4}, err)
+	assert.Nil(t, tokens)
+
+	drop := Transliterator{Tables: DefaultTransliterationTables, Mode: DropUnmappable}
+	tokens, err = NewPipeline().TransliterateChecked(drop)("user☃name")
+	assert.NoError(t, err)
+	assert.Equal(t, Tokens{"username"}, tokens)
+}
+
 // TestPipeline_JoinWith provides unit test coverage for Pipeline.JoinWith()
 func TestPipeline_JoinWith(t *testing.T) {
 	tests := []struct {
@@ -163,3 +278,30 @@ func TestPipeline_JoinWith(t *testing.T) {
 		})
 	}
 }
+
+// TestPipeline_JoinUsing provides unit test coverage for Pipeline.JoinUsing()
+func TestPipeline_JoinUsing(t *testing.T) {
+	tests := []struct {
+		name string
+		glue GlueFn
+		str  string
+		want string
+	}{
+		{
+			name: "basic",
+			glue: SepUnlessDigit("_"),
+			str:  "ab c 1",
+			want: "ab_c1",
+		},
+	}
+	pl := NewPipeline().TokenizeUsing(SimpleCategorizer, unicode.IsSpace, true)
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := pl.JoinUsing(tt.glue)
+			assert.Equal(t, tt.want, got(tt.str))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package wordcase
+
+import (
+	"strings"
+)
+
+// PluralSafeTokenizer is Tokenizer, but keeping plural initialisms together, eg "UserIDs" -> "User", "IDs".
+// Only tokens from the same word are joined, so "CPU Is" stays "CPU", "Is"
+var PluralSafeTokenizer = NewPipeline().
+	TokenizeUsing(LookAroundCategorizer, NotLetterOrDigit, true).
+	TokenizeEach(NewPipeline().
+		TokenizeUsing(CaseChangeCategorizer, NotLowerOrDigit, false).
+		KeepPlurals())
+
+// PluralSafeSnakeCase is SnakeCase, but keeping plural initialisms together, eg "UserIDs" -> "user_ids"
+var PluralSafeSnakeCase = PluralSafeTokenizer.
+	WithAllFormatter(strings.ToLower).
+	JoinWith("_")
+
+// PluralSafeKebabCase is KebabCase, but keeping plural initialisms together, eg "UserIDs" -> "user-ids"
+var PluralSafeKebabCase = PluralSafeTokenizer.
+	WithAllFormatter(strings.ToLower).
+	JoinWith("-")
+
+// PluralSafeDotCase is DotCase, but keeping plural initialisms together, eg "UserIDs" -> "user.ids"
+var PluralSafeDotCase = PluralSafeTokenizer.
+	WithAllFormatter(strings.ToLower).
+	JoinWith(".")
+
+// PluralSafeScreamingSnakeCase is ScreamingSnakeCase, but keeping plural initialisms together, eg "UserIDs" -> "USER_IDS"
+var PluralSafeScreamingSnakeCase = PluralSafeTokenizer.
+	WithAllFormatter(strings.ToUpper).
+	JoinWith("_")
+
+// PluralSafeCamelCase is CamelCase, but keeping plural initialisms together and same-cased, eg "user_ids" -> "userIDs"
+var PluralSafeCamelCase = PluralSafeTokenizer.
+	WithAllFormatter(strings.ToLower).
+	WithFormatter(UppercaseFirst, ToRest).
+	WithFormatter(strings.ToUpper, And(ToRest, LintWords)).
+	WithFormatter(UppercasePlural, And(ToRest, LintPlurals)).
+	JoinWith("")
+
+// PluralSafePascalCase is PascalCase, but keeping plural initialisms together and same-cased, eg "user_ids" -> "UserIDs"
+var PluralSafePascalCase = PluralSafeTokenizer.
+	WithAllFormatter(strings.ToLower).
+	WithAllFormatter(UppercaseFirst).
+	WithFormatter(strings.ToUpper, LintWords).
+	WithFormatter(UppercasePlural, LintPlurals).
+	JoinWith("")
+
+// PluralSafeWords is Words, but keeping plural initialisms together and same-cased, eg "user_ids" -> "user IDs"
+var PluralSafeWords = PluralSafeTokenizer.
+	WithFormatter(strings.ToUpper, LintWords).
+	WithFormatter(UppercasePlural, LintPlurals).
+	JoinWith(" ")
+
+// PluralSafeTitleCase is TitleCase, but keeping plural initialisms together and same-cased, eg "user_ids" -> "User IDs"
+var PluralSafeTitleCase = PluralSafeTokenizer.
+	WithAllFormatter(strings.ToLower).
+	WithFormatter(strings.ToUpper, LintWords).
+	WithFormatter(UppercasePlural, LintPlurals).
+	WithAllFormatter(UppercaseFirst).
+	JoinWith(" ")
+
+// PluralSafeGoExported is GoExported, but keeping plural initialisms together and same-cased, eg "user_ids" -> "UserIDs"
+var PluralSafeGoExported = goExportedRules.Apply(PluralSafePascalCase)
+
+// PluralSafeGoUnexported is GoUnexported, but keeping plural initialisms together and same-cased, eg "user_ids" -> "userIDs"
+var PluralSafeGoUnexported = GoRules.Apply(PluralSafeCamelCase)
@@ -0,0 +1,73 @@
+package wordcase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPluralSafe provides unit test coverage for the plural safe styles
+func TestPluralSafe(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   Combiner
+		want []string
+	}{
+		{name: "snake", fn: PluralSafeSnakeCase, want: []string{"user_ids", "urls_for_apis", "http_servers"}},
+		{name: "kebab", fn: PluralSafeKebabCase, want: []string{"user-ids", "urls-for-apis", "http-servers"}},
+		{name: "dot", fn: PluralSafeDotCase, want: []string{"user.ids", "urls.for.apis", "http.servers"}},
+		{name: "screaming snake", fn: PluralSafeScreamingSnakeCase, want: []string{"USER_IDS", "URLS_FOR_APIS", "HTTP_SERVERS"}},
+		{name: "camel", fn: PluralSafeCamelCase, want: []string{"userIDs", "urlsForAPIs", "httpServers"}},
+		{name: "pascal", fn: PluralSafePascalCase, want: []string{"UserIDs", "URLsForAPIs", "HTTPServers"}},
+		{name: "words", fn: PluralSafeWords, want: []string{"User IDs", "URLs For APIs", "HTTP Servers"}},
+		{name: "title", fn: PluralSafeTitleCase, want: []string{"User IDs", "URLs For APIs", "HTTP Servers"}},
+		{name: "go exported", fn: PluralSafeGoExported, want: []string{"UserIDs", "URLsForAPIs", "HTTPServers"}},
+		{name: "go unexported", fn: PluralSafeGoUnexported, want: []string{"userIDs", "urlsForAPIs", "httpServers"}},
+	}
+
+	inputs := []string{"UserIDs", "URLsForAPIs", "HTTPServers"}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			for i, in := range inputs {
+				got := tt.fn(in)
+				assert.Equal(t, tt.want[i], got, in)
+				assert.Equal(t, got, tt.fn(got), "idempotent for %q", in)
+			}
+		})
+	}
+}
+
+// TestPluralSafeFromLowercase provides unit test coverage for the plural safe styles restoring plural initialisms
+func TestPluralSafeFromLowercase(t *testing.T) {
+	assert.Equal(t, "userIDs", PluralSafeCamelCase("user_ids"))
+	assert.Equal(t, "UserIDs", PluralSafePascalCase("user-ids"))
+	assert.Equal(t, "List URLs", PluralSafeTitleCase("list urls"))
+	assert.Equal(t, "user_i_ds", SnakeCase("UserIDs"), "the standard styles split plural initialisms")
+}
+
+// TestPluralSafeSeparators checks plural initialisms are only kept together within a word
+func TestPluralSafeSeparators(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "CPU Is Busy", want: "cpu_is_busy"},
+		{s: "A Bs", want: "a_bs"},
+		{s: "ID_As", want: "id_as"},
+		{s: "ID-Ds", want: "id_ds"},
+		{s: "list URLs, IDs", want: "list_urls_ids"},
+		{s: "UserIDs_URLs", want: "user_ids_urls"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, PluralSafeSnakeCase(tt.s))
+		})
+	}
+	assert.Equal(t, "CPU Is Busy", PluralSafeWords("CPU Is Busy"))
+	assert.Equal(t, "cpuIsBusy", PluralSafeCamelCase("CPU Is Busy"))
+}
@@ -0,0 +1,132 @@
+package wordcase
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// IdentifierPattern matches identifier-like text: letters, digits and underscores, not starting with a digit,
+// eg "user_id", "userID", "_private"
+var IdentifierPattern = regexp.MustCompile(`[\p{L}_][\p{L}\p{N}_]*`)
+
+// HyphenatedIdentifierPattern is IdentifierPattern that also allows hyphens between words, eg "user-id", "max-age"
+var HyphenatedIdentifierPattern = regexp.MustCompile(`[\p{L}_][\p{L}\p{N}_]*(?:-[\p{L}\p{N}_]+)*`)
+
+// CodeSpanPattern matches the text of Markdown code spans, eg "`userName`" (the identifier is the first group)
+var CodeSpanPattern = regexp.MustCompile("`([\\p{L}_][\\p{L}\\p{N}_]*)`")
+
+// Rewriter finds identifiers in text and converts them to a style, leaving everything else unchanged
+type Rewriter struct {
+	Style   Combiner       // converts the identifiers
+	Pattern *regexp.Regexp // finds candidate identifiers, or the first group does if it has one; default IdentifierPattern
+	Only    []string       // only convert candidates DetectStyle reports as in one of these styles, eg "camel"; any if empty
+	Skip    WordSet        // candidates never converted, compared in lowercase, eg keywords
+}
+
+// Match is a candidate identifier found in text
+type Match struct {
+	Start int    // the byte offset of the start of the identifier
+	End   int    // the byte offset after the end of the identifier
+	Text  string // the identifier
+	Style string // the style DetectStyle reports it's in
+}
+
+// Edit is a change made to text: the bytes from Start to End were Old, and were replaced with New
+type Edit struct {
+	Start int
+	End   int
+	Old   string
+	New   string
+}
+
+// Find returns the candidate identifiers in the text that the rewriter would convert, in order.
+//
+//	A match that's part of a longer word (eg "abc" in "3abc") isn't a candidate
+func (r Rewriter) Find(text string) []Match {
+	re := r.Pattern
+	if re == nil {
+		re = IdentifierPattern
+	}
+
+	var ret []Match
+	for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		if len(loc) >= 4 {
+			start, end = loc[2], loc[3]
+		}
+		if start < 0 || start == end || !atWordBoundary(text, start, end) {
+			continue
+		}
+		id := text[start:end]
+		if r.Skip.Has(strings.ToLower(id)) {
+			continue
+		}
+		style := DetectStyle(id)
+		if len(r.Only) > 0 && !containsString(r.Only, style) {
+			continue
+		}
+		ret = append(ret, Match{Start: start, End: end, Text: id, Style: style})
+	}
+	return ret
+}
+
+// Rewrite converts the candidate identifiers in the text to the style, returning the new text and the edits made,
+// with offsets into the original text. Identifiers already in the style aren't edited.
+//
+//	Leading and trailing underscores are kept around the converted name, eg "_cache_key" -> "_cacheKey", as they
+//	usually mean something to the language (eg private or special names, "__init__")
+func (r Rewriter) Rewrite(text string) (string, []Edit) {
+	var edits []Edit
+	var b strings.Builder
+	at := 0
+	for _, m := range r.Find(text) {
+		core := strings.TrimLeft(m.Text, "_")
+		lead := m.Text[:len(m.Text)-len(core)]
+		core = strings.TrimRight(core, "_")
+		trail := m.Text[len(lead)+len(core):]
+		if core == "" {
+			continue
+		}
+		n := r.Style(core)
+		if n == "" {
+			continue
+		}
+		n = lead + n + trail
+		if n == m.Text {
+			continue
+		}
+		edits = append(edits, Edit{Start: m.Start, End: m.End, Old: m.Text, New: n})
+		b.WriteString(text[at:m.Start])
+		b.WriteString(n)
+		at = m.End
+	}
+	if len(edits) == 0 {
+		return text, nil
+	}
+	b.WriteString(text[at:])
+	return b.String(), edits
+}
+
+// atWordBoundary returns true if the text either side of start and end doesn't continue the word
+func atWordBoundary(text string, start, end int) bool {
+	before, _ := utf8.DecodeLastRuneInString(text[:start])
+	after, _ := utf8.DecodeRuneInString(text[end:])
+	return !(start > 0 && isWordRune(before)) && !(end < len(text) && isWordRune(after))
+}
+
+// isWordRune returns true for the runes identifiers are made of
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// containsString returns true if the list has the string
+func containsString(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
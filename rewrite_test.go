@@ -0,0 +1,169 @@
+package wordcase
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRewriter_Find provides unit test coverage for Rewriter.Find()
+func TestRewriter_Find(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Rewriter
+		text string
+		want []Match
+	}{
+		{
+			name: "empty",
+			r:    Rewriter{Style: SnakeCase},
+			text: "",
+		},
+		{
+			name: "identifiers",
+			r:    Rewriter{Style: SnakeCase},
+			text: "SELECT userName FROM t",
+			want: []Match{
+				{Start: 0, End: 6, Text: "SELECT", Style: "screaming-snake"},
+				{Start: 7, End: 15, Text: "userName", Style: "camel"},
+				{Start: 16, End: 20, Text: "FROM", Style: "screaming-snake"},
+				{Start: 21, End: 22, Text: "t", Style: "snake"},
+			},
+		},
+		{
+			name: "only",
+			r:    Rewriter{Style: SnakeCase, Only: []string{"camel", "pascal"}},
+			text: "SELECT userName, UserID FROM t",
+			want: []Match{
+				{Start: 7, End: 15, Text: "userName", Style: "camel"},
+				{Start: 17, End: 23, Text: "UserID", Style: "pascal"},
+			},
+		},
+		{
+			name: "skip",
+			r:    Rewriter{Style: SnakeCase, Skip: NewWordSet("select", "from")},
+			text: "SELECT userName FROM t",
+			want: []Match{
+				{Start: 7, End: 15, Text: "userName", Style: "camel"},
+				{Start: 21, End: 22, Text: "t", Style: "snake"},
+			},
+		},
+		{
+			name: "part of a word",
+			r:    Rewriter{Style: SnakeCase},
+			text: "3abc x",
+			want: []Match{{Start: 5, End: 6, Text: "x", Style: "snake"}},
+		},
+		{
+			name: "hyphenated",
+			r:    Rewriter{Style: SnakeCase, Pattern: HyphenatedIdentifierPattern, Only: []string{"kebab"}},
+			text: "max-age: 10, well known",
+			want: []Match{{Start: 0, End: 7, Text: "max-age", Style: "kebab"}},
+		},
+		{
+			name: "code spans",
+			r:    Rewriter{Style: SnakeCase, Pattern: CodeSpanPattern},
+			text: "Set `userName` to the userName",
+			want: []Match{{Start: 5, End: 13, Text: "userName", Style: "camel"}},
+		},
+		{
+			name: "unicode offsets",
+			r:    Rewriter{Style: SnakeCase, Only: []string{"camel"}},
+			text: "café fooBar",
+			want: []Match{{Start: 6, End: 12, Text: "fooBar", Style: "camel"}},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.r.Find(tt.text)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestRewriter_Rewrite provides unit test coverage for Rewriter.Rewrite()
+func TestRewriter_Rewrite(t *testing.T) {
+	tests := []struct {
+		name      string
+		r         Rewriter
+		text      string
+		want      string
+		wantEdits []Edit
+	}{
+		{
+			name: "nothing to change",
+			r:    Rewriter{Style: SnakeCase},
+			text: "all in snake_case already",
+			want: "all in snake_case already",
+		},
+		{
+			name: "sql",
+			r:    Rewriter{Style: SnakeCase, Only: []string{"camel", "pascal"}},
+			text: "SELECT userName,\n\tUserID\nFROM accountUsers;",
+			want: "SELECT user_name,\n\tuser_id\nFROM account_users;",
+			wantEdits: []Edit{
+				{Start: 7, End: 15, Old: "userName", New: "user_name"},
+				{Start: 18, End: 24, Old: "UserID", New: "user_id"},
+				{Start: 30, End: 42, Old: "accountUsers", New: "account_users"},
+			},
+		},
+		{
+			name: "yaml keys",
+			r:    Rewriter{Style: CamelCase, Pattern: regexp.MustCompile(`(?m)^\s*([\w-]+):`)},
+			text: "max_conns: 10\nlog-level: debug_mode\n",
+			want: "maxConns: 10\nlogLevel: debug_mode\n",
+			wantEdits: []Edit{
+				{Start: 0, End: 9, Old: "max_conns", New: "maxConns"},
+				{Start: 14, End: 23, Old: "log-level", New: "logLevel"},
+			},
+		},
+		{
+			name: "leading and trailing underscores",
+			r:    Rewriter{Style: CamelCase, Only: []string{"snake"}},
+			text: "def __init__(self, user_id): self._cache_key = user_id",
+			want: "def __init__(self, userID): self._cacheKey = userID",
+			wantEdits: []Edit{
+				{Start: 19, End: 26, Old: "user_id", New: "userID"},
+				{Start: 34, End: 44, Old: "_cache_key", New: "_cacheKey"},
+				{Start: 47, End: 54, Old: "user_id", New: "userID"},
+			},
+		},
+		{
+			name: "only underscores",
+			r:    Rewriter{Style: PascalCase, Skip: NewWordSet("for", "range")},
+			text: "for _, x := range __ { _max_len_ = x }",
+			want: "for _, X := range __ { _MaxLen_ = X }",
+			wantEdits: []Edit{
+				{Start: 7, End: 8, Old: "x", New: "X"},
+				{Start: 23, End: 32, Old: "_max_len_", New: "_MaxLen_"},
+				{Start: 35, End: 36, Old: "x", New: "X"},
+			},
+		},
+		{
+			name: "markdown code spans",
+			r:    Rewriter{Style: SnakeCase, Pattern: CodeSpanPattern},
+			text: "Set `userName` (the userName) and `ok`.",
+			want: "Set `user_name` (the userName) and `ok`.",
+			wantEdits: []Edit{
+				{Start: 5, End: 13, Old: "userName", New: "user_name"},
+			},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, edits := tt.r.Rewrite(tt.text)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantEdits, edits)
+			for _, e := range edits {
+				assert.Equal(t, e.Old, tt.text[e.Start:e.End])
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package wordcase
 
 import (
-	"sort"
+	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // TokenSelector is a function given a set of tokens, returns an array of
@@ -10,14 +12,20 @@ import (
 //	indices to the token matching a condition defined in the function
 type TokenSelector func(t Tokens) []int
 
-// ToFirst returns the index of the first token
-func ToFirst(_ Tokens) []int {
+// ToFirst returns the index of the first token, or nothing if there are no tokens
+func ToFirst(t Tokens) []int {
+	if len(t) == 0 {
+		return nil
+	}
 	return []int{0}
 }
 
-// ToLast returns the index of the last token
+// ToLast returns the index of the last token, or nothing if there are no tokens
 func ToLast(t Tokens) []int {
-	return []int{len(t) - 1} // Note negative indices are ok. The formatter doesn't use them, so bad addressing is avoided
+	if len(t) == 0 {
+		return nil
+	}
+	return []int{len(t) - 1}
 }
 
 // ToRest returns the indices of all but the first token
@@ -64,66 +72,184 @@ var KeyWords = KeyWordFn(UsefulKeyWords)
 // LintWords returns the indices of tokens that matches keywords used by go lint
 var LintWords = KeyWordFn(GoLintKeywords)
 
-// Not inverts the given selector's matches
-func Not(sFn TokenSelector) TokenSelector {
+// PluralKeyWordFn returns a new selector that matches tokens that are one of the given keywords followed by "s",
+// eg "ids" for the keyword "id". The keywords are assumed to be lowercase.
+func PluralKeyWordFn(keywords []string) TokenSelector {
+	kw := NewWordSet(keywords...)
 	return func(t Tokens) []int {
-		m := sFn(t)
 		var ret []int
-
-		at := 0
-		for _, i := range m {
-			for x := at; x < i; x++ {
-				ret = append(ret, x)
+		for i, s := range t {
+			l := strings.ToLower(s)
+			if stem, ok := strings.CutSuffix(l, "s"); ok && kw.Has(stem) {
+				ret = append(ret, i)
 			}
-			at = i + 1
 		}
-		for x := at; x < len(t); x++ {
-			ret = append(ret, x)
-		}
-
 		return ret
 	}
 }
 
+// LintPlurals returns the indices of tokens that are the plural of a keyword used by go lint, eg "ids", "urls"
+var LintPlurals = PluralKeyWordFn(GoLintKeywords)
+
+// Not inverts the given selector's matches
+func Not(sFn TokenSelector) TokenSelector {
+	return func(t Tokens) []int {
+		return t.Select(sFn).Complement().Indices()
+	}
+}
+
 // And returns a selector that matches tokens that are matched by both a & b
 func And(a, b TokenSelector) TokenSelector {
 	return func(t Tokens) []int {
-		one := a(t)
-		var ret []int
-
-		two := make(map[int]bool)
-		for _, v := range b(t) {
-			two[v] = true
-		}
-
-		for _, i := range one {
-			if two[i] {
-				ret = append(ret, i)
-			}
-		}
-
-		return ret
+		return t.Select(a).Intersect(t.Select(b)).Indices()
 	}
 }
 
 // Or returns a selector that matches tokens that are matched by either a or b
 func Or(a, b TokenSelector) TokenSelector {
 	return func(t Tokens) []int {
-		one := a(t)
-		ret := b(t)
+		return t.Select(a).Union(t.Select(b)).Indices()
+	}
+}
 
-		seen := make(map[int]bool)
+// Except returns a selector that matches tokens that are matched by a but not by b
+func Except(a, b TokenSelector) TokenSelector {
+	return func(t Tokens) []int {
+		return t.Select(a).Difference(t.Select(b)).Indices()
+	}
+}
 
-		for _, i := range ret {
-			seen[i] = true
-		}
+// TokenContext gives a TokenPredicate access to a token and its surroundings
+type TokenContext struct {
+	Tokens Tokens // all the tokens being selected from
+	Index  int    // the index of the token being tested
+}
+
+// Token returns the token being tested
+func (c TokenContext) Token() string {
+	return c.Tokens[c.Index]
+}
+
+// Prev returns the token before the one being tested, or an empty string if it's the first
+func (c TokenContext) Prev() string {
+	if c.Index < 1 {
+		return ""
+	}
+	return c.Tokens[c.Index-1]
+}
 
-		for _, i := range one {
-			if !seen[i] {
+// Next returns the token after the one being tested, or an empty string if it's the last
+func (c TokenContext) Next() string {
+	if c.Index >= len(c.Tokens)-1 {
+		return ""
+	}
+	return c.Tokens[c.Index+1]
+}
+
+// Count returns the total number of tokens
+func (c TokenContext) Count() int {
+	return len(c.Tokens)
+}
+
+// TokenPredicate decides if the token described by the given context should be selected
+type TokenPredicate func(c TokenContext) bool
+
+// Where returns a selector that matches each token for which the predicate returns true
+func Where(p TokenPredicate) TokenSelector {
+	return func(t Tokens) []int {
+		var ret []int
+		for i := range t {
+			if p(TokenContext{Tokens: t, Index: i}) {
 				ret = append(ret, i)
 			}
 		}
-		sort.Ints(ret)
 		return ret
 	}
 }
+
+// ToNth returns a selector that matches the token at index n.
+//
+//	Negative values count back from the end, so ToNth(-1) is the last token
+func ToNth(n int) TokenSelector {
+	return Where(func(c TokenContext) bool {
+		if n < 0 {
+			return c.Index == c.Count()+n
+		}
+		return c.Index == n
+	})
+}
+
+// ToRange returns a selector that matches tokens with an index from 'from' up to, but not including, 'to'
+func ToRange(from, to int) TokenSelector {
+	return Where(func(c TokenContext) bool {
+		return c.Index >= from && c.Index < to
+	})
+}
+
+// ToLastN returns a selector that matches the last n tokens
+func ToLastN(n int) TokenSelector {
+	return Where(func(c TokenContext) bool {
+		return c.Index >= c.Count()-n
+	})
+}
+
+// Matching returns a selector that matches tokens that match the given regular expression
+func Matching(re *regexp.Regexp) TokenSelector {
+	return Where(func(c TokenContext) bool {
+		return re.MatchString(c.Token())
+	})
+}
+
+// LongerThan returns a selector that matches tokens with more than n runes
+func LongerThan(n int) TokenSelector {
+	return Where(func(c TokenContext) bool {
+		return utf8.RuneCountInString(c.Token()) > n
+	})
+}
+
+// Numeric returns the indices of tokens made up entirely of digits
+func Numeric(t Tokens) []int {
+	return Where(func(c TokenContext) bool {
+		return c.Token() != "" && strings.IndexFunc(c.Token(), notDigit) == -1
+	})(t)
+}
+
+// AllUpper returns the indices of tokens that contain letters, all of which are uppercase
+func AllUpper(t Tokens) []int {
+	return Where(func(c TokenContext) bool {
+		s := c.Token()
+		return strings.IndexFunc(s, unicode.IsLetter) != -1 && strings.IndexFunc(s, unicode.IsLower) == -1
+	})(t)
+}
+
+// notDigit returns true if the given rune isn't a digit
+func notDigit(r rune) bool {
+	return !unicode.IsDigit(r)
+}
+
+// Xor returns a selector that matches tokens that are matched by exactly one of a or b
+func Xor(a, b TokenSelector) TokenSelector {
+	return func(t Tokens) []int {
+		return t.Select(a).SymmetricDifference(t.Select(b)).Indices()
+	}
+}
+
+// Preceding returns a selector that matches tokens immediately before a token matched by sel
+func Preceding(sel TokenSelector) TokenSelector {
+	return func(t Tokens) []int {
+		m := t.Select(sel)
+		return Where(func(c TokenContext) bool {
+			return m.Has(c.Index + 1)
+		})(t)
+	}
+}
+
+// Following returns a selector that matches tokens immediately after a token matched by sel
+func Following(sel TokenSelector) TokenSelector {
+	return func(t Tokens) []int {
+		m := t.Select(sel)
+		return Where(func(c TokenContext) bool {
+			return m.Has(c.Index - 1)
+		})(t)
+	}
+}
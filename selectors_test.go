@@ -1,6 +1,7 @@
 package wordcase
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,7 @@ func TestFirst(t *testing.T) {
 		{
 			name: "empty",
 			args: Args{t: Tokens{}},
-			want: []int{0},
+			want: nil,
 		},
 		{
 			name: "one",
@@ -63,7 +64,7 @@ func TestLast(t *testing.T) {
 		{
 			name: "empty",
 			args: Args{t: Tokens{}},
-			want: []int{-1},
+			want: nil,
 		},
 		{
 			name: "one",
@@ -224,6 +225,39 @@ func TestKeyWordFn(t *testing.T) {
 	}
 }
 
+// TestPluralKeyWordFn provides unit test coverage for PluralKeyWordFn()
+func TestPluralKeyWordFn(t *testing.T) {
+	tests := []struct {
+		name     string
+		keywords []string
+		tokens   Tokens
+		want     []int
+	}{
+		{
+			name:     "nil matcher",
+			keywords: []string{},
+			tokens:   Tokens{"ids", "urls"},
+			want:     nil,
+		},
+		{
+			name:     "plurals only",
+			keywords: []string{"id", "url"},
+			tokens:   Tokens{"id", "IDs", "url", "URLS", "s"},
+			want:     []int{1, 3},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			kw := PluralKeyWordFn(tt.keywords)
+			got := kw(tt.tokens)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 // TestNot provides unit test coverage for Not()
 func TestNot(t *testing.T) {
 	tests := []struct {
@@ -286,6 +320,18 @@ func TestNot(t *testing.T) {
 			idx:    []int{0, 2},
 			want:   []int{1},
 		},
+		{
+			name:   "unsorted with duplicates",
+			tokens: Tokens{"one", "two", "three", "four"},
+			idx:    []int{2, 0, 2},
+			want:   []int{1, 3},
+		},
+		{
+			name:   "out of range",
+			tokens: Tokens{"one", "two"},
+			idx:    []int{-1, 1, 5},
+			want:   []int{0},
+		},
 	}
 
 	tsGen := func(i []int) TokenSelector {
@@ -357,6 +403,20 @@ func TestAnd(t *testing.T) {
 			two:    []int{1, 2},
 			want:   []int{1},
 		},
+		{
+			name:   "unsorted with duplicates",
+			tokens: Tokens{"one", "two", "three"},
+			one:    []int{2, 1, 2, 0},
+			two:    []int{2, 0, 0},
+			want:   []int{0, 2},
+		},
+		{
+			name:   "out of range",
+			tokens: Tokens{"one", "two"},
+			one:    []int{-1, 1, 2},
+			two:    []int{-1, 1, 2},
+			want:   []int{1},
+		},
 	}
 
 	tsGen := func(i []int) TokenSelector {
@@ -433,6 +493,20 @@ func TestOr(t *testing.T) {
 			two:    []int{1, 2},
 			want:   []int{0, 1, 2},
 		},
+		{
+			name:   "unsorted with duplicates",
+			tokens: Tokens{"one", "two", "three"},
+			one:    []int{2, 2},
+			two:    []int{1, 0, 1},
+			want:   []int{0, 1, 2},
+		},
+		{
+			name:   "out of range",
+			tokens: Tokens{"one", "two"},
+			one:    []int{-1, 0},
+			two:    []int{7},
+			want:   []int{0},
+		},
 	}
 
 	tsGen := func(i []int) TokenSelector {
@@ -457,3 +531,195 @@ func TestOr(t *testing.T) {
 		})
 	}
 }
+
+// TestTokenContext provides unit test coverage for TokenContext
+func TestTokenContext(t *testing.T) {
+	tests := []struct {
+		name      string
+		c         TokenContext
+		wantToken string
+		wantPrev  string
+		wantNext  string
+		wantCount int
+	}{
+		{
+			name:      "only",
+			c:         TokenContext{Tokens: Tokens{"one"}, Index: 0},
+			wantToken: "one",
+			wantCount: 1,
+		},
+		{
+			name:      "first",
+			c:         TokenContext{Tokens: Tokens{"one", "two", "three"}, Index: 0},
+			wantToken: "one",
+			wantNext:  "two",
+			wantCount: 3,
+		},
+		{
+			name:      "middle",
+			c:         TokenContext{Tokens: Tokens{"one", "two", "three"}, Index: 1},
+			wantToken: "two",
+			wantPrev:  "one",
+			wantNext:  "three",
+			wantCount: 3,
+		},
+		{
+			name:      "last",
+			c:         TokenContext{Tokens: Tokens{"one", "two", "three"}, Index: 2},
+			wantToken: "three",
+			wantPrev:  "two",
+			wantCount: 3,
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.wantToken, tt.c.Token())
+			assert.Equal(t, tt.wantPrev, tt.c.Prev())
+			assert.Equal(t, tt.wantNext, tt.c.Next())
+			assert.Equal(t, tt.wantCount, tt.c.Count())
+		})
+	}
+}
+
+// TestContextSelectors provides unit test coverage for the context-aware selectors
+func TestContextSelectors(t *testing.T) {
+	tests := []struct {
+		name   string
+		sel    TokenSelector
+		tokens Tokens
+		want   []int
+	}{
+		{
+			name:   "where empty",
+			sel:    Where(func(c TokenContext) bool { return true }),
+			tokens: Tokens{},
+			want:   nil,
+		},
+		{
+			name:   "where previous is v",
+			sel:    Where(func(c TokenContext) bool { return c.Prev() == "v" }),
+			tokens: Tokens{"api", "v", "two", "v"},
+			want:   []int{2},
+		},
+		{
+			name:   "nth",
+			sel:    ToNth(1),
+			tokens: Tokens{"one", "two", "three"},
+			want:   []int{1},
+		},
+		{
+			name:   "nth from end",
+			sel:    ToNth(-2),
+			tokens: Tokens{"one", "two", "three"},
+			want:   []int{1},
+		},
+		{
+			name:   "nth out of range",
+			sel:    ToNth(5),
+			tokens: Tokens{"one", "two", "three"},
+			want:   nil,
+		},
+		{
+			name:   "range",
+			sel:    ToRange(1, 3),
+			tokens: Tokens{"one", "two", "three", "four"},
+			want:   []int{1, 2},
+		},
+		{
+			name:   "range past end",
+			sel:    ToRange(2, 10),
+			tokens: Tokens{"one", "two", "three"},
+			want:   []int{2},
+		},
+		{
+			name:   "last n",
+			sel:    ToLastN(2),
+			tokens: Tokens{"one", "two", "three"},
+			want:   []int{1, 2},
+		},
+		{
+			name:   "last n more than there are",
+			sel:    ToLastN(5),
+			tokens: Tokens{"one", "two"},
+			want:   []int{0, 1},
+		},
+		{
+			name:   "last zero",
+			sel:    ToLastN(0),
+			tokens: Tokens{"one", "two"},
+			want:   nil,
+		},
+		{
+			name:   "matching",
+			sel:    Matching(regexp.MustCompile(`^t`)),
+			tokens: Tokens{"one", "two", "three"},
+			want:   []int{1, 2},
+		},
+		{
+			name:   "longer than",
+			sel:    LongerThan(3),
+			tokens: Tokens{"one", "two", "three", "äöüß"},
+			want:   []int{2, 3},
+		},
+		{
+			name:   "numeric",
+			sel:    Numeric,
+			tokens: Tokens{"v", "2", "99a", "", "42"},
+			want:   []int{1, 4},
+		},
+		{
+			name:   "all upper",
+			sel:    AllUpper,
+			tokens: Tokens{"ID", "Id", "99", "V2", "x"},
+			want:   []int{0, 3},
+		},
+		{
+			name:   "xor",
+			sel:    Xor(ToRange(0, 2), ToRange(1, 3)),
+			tokens: Tokens{"one", "two", "three", "four"},
+			want:   []int{0, 2},
+		},
+		{
+			name:   "except",
+			sel:    Except(ToAll, ToRange(1, 3)),
+			tokens: Tokens{"one", "two", "three", "four"},
+			want:   []int{0, 3},
+		},
+		{
+			name:   "xor unsorted",
+			sel:    Xor(func(Tokens) []int { return []int{2, 0, 2} }, ToFirst),
+			tokens: Tokens{"one", "two", "three"},
+			want:   []int{2},
+		},
+		{
+			name:   "preceding",
+			sel:    Preceding(Numeric),
+			tokens: Tokens{"api", "v", "2", "one", "3"},
+			want:   []int{1, 3},
+		},
+		{
+			name:   "following",
+			sel:    Following(KeyWordFn([]string{"v"})),
+			tokens: Tokens{"v", "two", "three", "v"},
+			want:   []int{1},
+		},
+		{
+			name:   "digits after a keyword",
+			sel:    And(Numeric, Following(KeyWordFn([]string{"v"}))),
+			tokens: Tokens{"v", "2", "x", "3", "V", "4"},
+			want:   []int{1, 5},
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.sel(tt.tokens)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
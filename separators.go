@@ -1,6 +1,7 @@
 package wordcase
 
 import (
+	"strings"
 	"unicode"
 )
 
@@ -46,3 +47,20 @@ func SimpleCategorizer(word string, idx int, test IsRuneSeparator) bool {
 	w := []rune(word)
 	return test(w[idx])
 }
+
+// CaseChangeCategorizer considers a rune to be a separator if it passes test(c),
+//
+//	and either the preceding rune doesn't, or the succeeding rune is a lowercase letter.
+//	Unlike LookAroundCategorizer, a run of separators followed by a digit is kept together (eg "UTF8", "ID2"),
+//	while still being split from a following capitalised word (eg "XMLHttp" -> "XML", "Http").
+//	A word without any lowercase letters has no case changes, so is never split (eg "A1B")
+func CaseChangeCategorizer(word string, idx int, test IsRuneSeparator) bool {
+	w := []rune(word)
+	if !test(w[idx]) || strings.IndexFunc(word, unicode.IsLower) == -1 {
+		return false
+	}
+	if idx > 0 && !test(w[idx-1]) {
+		return true
+	}
+	return idx < len(w)-1 && unicode.IsLower(w[idx+1])
+}
@@ -394,3 +394,35 @@ func Test_LookAroundSeparator(t *testing.T) {
 		})
 	}
 }
+
+func Test_CaseChangeSeparator(t *testing.T) {
+	tests := []struct {
+		word string
+		idx  int
+		want bool
+	}{
+		{word: "aaa", idx: 1, want: false},
+		{word: "aAa", idx: 1, want: true},
+		{word: "AAa", idx: 1, want: true},
+		{word: "aAA", idx: 1, want: true},
+		{word: "AAA", idx: 1, want: false},
+		{word: "AA1", idx: 1, want: false},
+		{word: "1A1", idx: 1, want: false},
+		{word: "1Aa", idx: 1, want: true},
+		{word: "a1A", idx: 2, want: true},
+		{word: "aA1", idx: 1, want: true},
+		{word: "AA", idx: 1, want: false},
+		{word: "aA", idx: 1, want: true},
+		{word: "Aa", idx: 0, want: true},
+		{word: "A1", idx: 0, want: false},
+		{word: "AA", idx: 0, want: false},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.word+"@"+string(rune('0'+tt.idx)), func(t *testing.T) {
+			t.Parallel()
+			got := CaseChangeCategorizer(tt.word, tt.idx, unicode.IsUpper)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package wordcase
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// CommonAbbreviations are widely understood abbreviations of words, by the lowercase word
+var CommonAbbreviations = map[string]string{
+	"address":        "addr",
+	"administrator":  "admin",
+	"application":    "app",
+	"argument":       "arg",
+	"attribute":      "attr",
+	"authentication": "authn",
+	"authorization":  "authz",
+	"average":        "avg",
+	"button":         "btn",
+	"calculate":      "calc",
+	"certificate":    "cert",
+	"character":      "char",
+	"column":         "col",
+	"configuration":  "config",
+	"connection":     "conn",
+	"context":        "ctx",
+	"count":          "cnt",
+	"current":        "cur",
+	"database":       "db",
+	"default":        "def",
+	"definition":     "def",
+	"department":     "dept",
+	"description":    "desc",
+	"destination":    "dest",
+	"development":    "dev",
+	"directory":      "dir",
+	"document":       "doc",
+	"environment":    "env",
+	"error":          "err",
+	"function":       "func",
+	"identifier":     "id",
+	"image":          "img",
+	"index":          "idx",
+	"information":    "info",
+	"initialize":     "init",
+	"language":       "lang",
+	"length":         "len",
+	"library":        "lib",
+	"management":     "mgmt",
+	"manager":        "mgr",
+	"maximum":        "max",
+	"message":        "msg",
+	"minimum":        "min",
+	"namespace":      "ns",
+	"number":         "num",
+	"organization":   "org",
+	"parameter":      "param",
+	"password":       "pwd",
+	"position":       "pos",
+	"previous":       "prev",
+	"production":     "prod",
+	"quantity":       "qty",
+	"reference":      "ref",
+	"repository":     "repo",
+	"request":        "req",
+	"response":       "resp",
+	"sequence":       "seq",
+	"service":        "svc",
+	"source":         "src",
+	"specification":  "spec",
+	"statistics":     "stats",
+	"string":         "str",
+	"synchronize":    "sync",
+	"temporary":      "tmp",
+	"timestamp":      "ts",
+	"transaction":    "txn",
+	"utility":        "util",
+	"value":          "val",
+	"version":        "ver",
+}
+
+// ShortenRules describe how identifiers are shortened to fit a maximum length
+type ShortenRules struct {
+	MaxLength     int               // the maximum length in bytes, eg 63 for Kubernetes labels and Postgres identifiers
+	Abbreviations map[string]string // abbreviations by lowercase word; CommonAbbreviations if nil
+	LowPriority   WordSet           // lowercase words that can be left out; the EnglishStopWords if nil
+	MinVowelDrop  int               // the shortest word (in runes) that can lose its vowels; default 5
+}
+
+// The defaults for ShortenRules
+const (
+	defaultMinVowelDrop = 5
+	shortHashLength     = 6
+)
+
+// defaultLowPriority are the words ShortenRules leaves out by default
+var defaultLowPriority = NewWordSet(EnglishStopWords...)
+
+// Shorten returns a Combiner that converts a string with the style, shortening it to at most maxLength bytes where
+// needed (see ShortenRules.Apply), eg Shorten(KebabCase, 22)("number of configuration attempts") -> "num-of-config-attempts"
+func Shorten(style Combiner, maxLength int) Combiner {
+	return ShortenRules{MaxLength: maxLength}.Apply(style)
+}
+
+// Apply returns a Combiner that converts a string with the style, and if the result is too long, shortens its words
+// until it fits.
+//
+//	The words are shortened in turn by:
+//	 - abbreviating words from the dictionary, longest first, eg "configuration" -> "config"
+//	 - dropping the vowels (after the first letter) of long words, longest first, eg "attempts" -> "attmpts"
+//	 - leaving out low-priority words, last first, eg "of"
+//	 - cutting words from the end and adding a short hash of the string, so different strings stay different
+//	The shortened words (and the hash, which is all digits) are converted with the style, so converting the result with
+//	the style again leaves it unchanged. Only a maximum length too short for the hash can give a result cut without
+//	regard to the style
+func (r ShortenRules) Apply(style Combiner) Combiner {
+	return func(s string) string {
+		out := style(s)
+		if r.MaxLength <= 0 || len(out) <= r.MaxLength {
+			return out
+		}
+
+		words := Tokenizer(s)
+		fits := func() (string, bool) {
+			out := style(words.Join(" "))
+			return out, len(out) <= r.MaxLength
+		}
+
+		abbreviations := r.Abbreviations
+		if abbreviations == nil {
+			abbreviations = CommonAbbreviations
+		}
+		for _, i := range longestFirst(words) {
+			if a, ok := abbreviations[strings.ToLower(words[i])]; ok {
+				words[i] = a
+				if out, ok := fits(); ok {
+					return out
+				}
+			}
+		}
+
+		minVowelDrop := r.MinVowelDrop
+		if minVowelDrop <= 0 {
+			minVowelDrop = defaultMinVowelDrop
+		}
+		for _, i := range longestFirst(words) {
+			if utf8.RuneCountInString(words[i]) >= minVowelDrop {
+				words[i] = dropVowels(words[i])
+				if out, ok := fits(); ok {
+					return out
+				}
+			}
+		}
+
+		lowPriority := r.LowPriority
+		if lowPriority == nil {
+			lowPriority = defaultLowPriority
+		}
+		for i := len(words) - 1; i >= 0 && len(words) > 1; i-- {
+			if lowPriority.Has(strings.ToLower(words[i])) {
+				words = append(words[:i], words[i+1:]...)
+				if out, ok := fits(); ok {
+					return out
+				}
+			}
+		}
+
+		return r.truncate(style, words, shortHash(s))
+	}
+}
+
+// truncate cuts words from the end, and then runes from the last word, until the words and the hash fit
+func (r ShortenRules) truncate(style Combiner, words Tokens, hash string) string {
+	for len(words) > 0 {
+		if out := style(append(words, hash).Join(" ")); len(out) <= r.MaxLength {
+			return out
+		}
+		last := []rune(words[len(words)-1])
+		if len(last) > 1 {
+			words[len(words)-1] = string(last[:len(last)-1])
+		} else {
+			words = words[:len(words)-1]
+		}
+	}
+	return truncateBytes(style(hash), r.MaxLength)
+}
+
+// longestFirst returns the indexes of the words, longest first (and in order when the lengths are equal)
+func longestFirst(words Tokens) []int {
+	idx := make([]int, len(words))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		return utf8.RuneCountInString(words[idx[a]]) > utf8.RuneCountInString(words[idx[b]])
+	})
+	return idx
+}
+
+// dropVowels removes the vowels after the first letter of a word, eg "number" -> "nmbr", "attempts" -> "attmpts"
+func dropVowels(s string) string {
+	first, size := utf8.DecodeRuneInString(s)
+	return string(first) + strings.Map(func(r rune) rune {
+		if strings.ContainsRune("aeiouAEIOU", r) {
+			return -1
+		}
+		return r
+	}, s[size:])
+}
+
+// shortHash returns a short stable hash of the string, in digits only so that no style splits it into words, or joins
+// it to the letters before it differently when it's converted again
+func shortHash(s string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(s))
+	return fmt.Sprintf("%010d", h.Sum32())[10-shortHashLength:]
+}
@@ -0,0 +1,134 @@
+package wordcase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestShorten provides unit test coverage for Shorten()
+func TestShorten(t *testing.T) {
+	tests := []struct {
+		name      string
+		style     Combiner
+		maxLength int
+		s         string
+		want      string
+	}{
+		{name: "fits", style: KebabCase, maxLength: 63, s: "number of attempts", want: "number-of-attempts"},
+		{name: "no limit", style: KebabCase, maxLength: 0, s: "number of attempts", want: "number-of-attempts"},
+		{name: "abbreviation", style: KebabCase, maxLength: 25, s: "number of configuration attempts", want: "number-of-config-attempts"},
+		{name: "abbreviations", style: KebabCase, maxLength: 22, s: "number of configuration attempts", want: "num-of-config-attempts"},
+		{name: "vowels", style: KebabCase, maxLength: 20, s: "number of configuration attempts", want: "num-of-cnfg-attmpts"},
+		{name: "low priority", style: KebabCase, maxLength: 16, s: "number of configuration attempts", want: "num-cnfg-attmpts"},
+		{name: "hash", style: KebabCase, maxLength: 12, s: "number of configuration attempts", want: "num-c-202235"},
+		{name: "hash snake", style: SnakeCase, maxLength: 8, s: "number of configuration attempts", want: "n_202235"},
+		{name: "hash in style", style: ScreamingSnakeCase, maxLength: 8, s: "customer account identifier", want: "C_789193"},
+		{name: "pascal", style: PascalCase, maxLength: 14, s: "number of configuration attempts", want: "NumCnfgAttmpts"},
+		{name: "too short for hash", style: SnakeCase, maxLength: 2, s: "abc", want: "92"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Shorten(tt.style, tt.maxLength)(tt.s)
+			assert.Equal(t, tt.want, got)
+			if tt.maxLength > 0 {
+				assert.LessOrEqual(t, len(got), tt.maxLength)
+			}
+		})
+	}
+}
+
+// TestShorten_valid checks shortened names stay in the style, and different names stay different
+func TestShorten_valid(t *testing.T) {
+	long := "the quick brown fox jumps over the lazy dog while the cat watches from the window"
+	styles := map[string]Combiner{
+		"snake": SnakeCase, "kebab": KebabCase, "camel": CamelCase, "pascal": PascalCase,
+		"screaming-snake": ScreamingSnakeCase,
+	}
+	for name, style := range styles {
+		for _, n := range []int{8, 12, 30, 63} {
+			got := Shorten(style, n)(long)
+			assert.LessOrEqual(t, len(got), n)
+			want := name
+			if name == "camel" && got == strings.ToLower(got) {
+				want = "snake" // a camel case name of one word, eg "qc970120", can't be told from snake case
+			}
+			assert.Equal(t, want, DetectStyle(got), "%q", got)
+			assert.Equal(t, got, style(got), "%q is in the style", got)
+		}
+	}
+
+	a := Shorten(SnakeCase, 10)(long + " a")
+	b := Shorten(SnakeCase, 10)(long + " b")
+	assert.NotEqual(t, a, b)
+}
+
+// TestShortenRules_Apply provides unit test coverage for ShortenRules.Apply()
+func TestShortenRules_Apply(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules ShortenRules
+		s     string
+		want  string
+	}{
+		{
+			name:  "own abbreviations",
+			rules: ShortenRules{MaxLength: 12, Abbreviations: map[string]string{"customer": "cust"}},
+			s:     "customer name",
+			want:  "cust_name",
+		},
+		{
+			name:  "no abbreviations",
+			rules: ShortenRules{MaxLength: 11, Abbreviations: map[string]string{}},
+			s:     "number value",
+			want:  "nmbr_value",
+		},
+		{
+			name:  "vowel drop",
+			rules: ShortenRules{MaxLength: 10, Abbreviations: map[string]string{}},
+			s:     "unique name",
+			want:  "unq_name",
+		},
+		{
+			name:  "min vowel drop",
+			rules: ShortenRules{MaxLength: 10, Abbreviations: map[string]string{}, MinVowelDrop: 7},
+			s:     "unique name",
+			want:  "uni_241721",
+		},
+		{
+			name:  "own low priority",
+			rules: ShortenRules{MaxLength: 5, Abbreviations: map[string]string{}, LowPriority: NewWordSet("tmp")},
+			s:     "tmp id",
+			want:  "id",
+		},
+		{
+			name:  "byte length",
+			rules: ShortenRules{MaxLength: 10},
+			s:     "ééééé ééééé",
+			want:  "é_962219",
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.rules.Apply(SnakeCase)(tt.s)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, len(got), tt.rules.MaxLength)
+		})
+	}
+}
+
+// TestCommonAbbreviations checks the abbreviations are shorter lowercase words
+func TestCommonAbbreviations(t *testing.T) {
+	for word, abbr := range CommonAbbreviations {
+		assert.Less(t, len(abbr), len(word), word)
+		assert.Equal(t, strings.ToLower(word), word)
+		assert.Equal(t, strings.ToLower(abbr), abbr)
+	}
+}
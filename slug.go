@@ -0,0 +1,147 @@
+package wordcase
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// EnglishStopWords are common English words that add little to a slug
+var EnglishStopWords = []string{
+	"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "from", "if", "in", "into", "is", "it", "of", "on",
+	"or", "so", "than", "that", "the", "their", "then", "there", "these", "this", "to", "was", "were", "with",
+}
+
+// SlugRules describe how a slug is made
+type SlugRules struct {
+	Separator string  // placed between the words of the slug; default "-"
+	MaxLength int     // the maximum length of the slug in bytes, cutting on word boundaries where possible, or 0 for no limit
+	StopWords WordSet // lowercase words left out of the slug, unless that would leave it empty
+}
+
+// Slug converts a string into a URL slug: lowercase ASCII letters and digits, separated by hyphens.
+// Letters are transliterated to ASCII where possible (see Transliterate), apostrophes are dropped, and any other runes
+// separate words, eg "Crème Brûlée über Straße" -> "creme-brulee-uber-strasse", "Don't Panic!" -> "dont-panic"
+var Slug = SlugRules{}.Style()
+
+// UniqueSlug returns the slug, or if it exists, the slug with the first number from 2 up appended that doesn't exist
+// (see SlugRules.Unique)
+func UniqueSlug(slug string, exists func(string) (bool, error)) (string, error) {
+	return SlugRules{}.Unique(slug, exists)
+}
+
+// maxUniqueAttempts is the number of suffixes tried when making a unique slug before giving up
+const maxUniqueAttempts = 10000
+
+// slugTokenizer breaks transliterated text into the words of a slug
+var slugTokenizer = NewPipeline().
+	TokenizeUsing(SimpleCategorizer, isNotSlugRune, true)
+
+// isNotSlugRune returns true for runes that can't be part of a slug
+func isNotSlugRune(r rune) bool {
+	return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+}
+
+// apostrophes are dropped from words rather than separating them, eg "don't" -> "dont"
+var apostrophes = strings.NewReplacer("'", "", "’", "")
+
+// Style returns a Combiner that converts a string into a slug following the rules
+func (r SlugRules) Style() Combiner {
+	return func(s string) string {
+		t := slugTokenizer(apostrophes.Replace(Transliterate(s))).FormatAll(strings.ToLower)
+		if len(r.StopWords) > 0 {
+			if kept := t.Drop(r.isStopWord); len(kept) > 0 {
+				t = kept
+			}
+		}
+		return r.truncate(t, r.MaxLength)
+	}
+}
+
+// Unique returns the slug, or if it exists, the slug with "-2", "-3", etc appended, for the first that doesn't exist.
+// When there's a maximum length, the slug is shortened to make room for the number
+func (r SlugRules) Unique(slug string, exists func(string) (bool, error)) (string, error) {
+	sep := r.separator()
+	candidate := slug
+	for n := 2; n < maxUniqueAttempts; n++ {
+		found, err := exists(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			return candidate, nil
+		}
+		suffix := sep + strconv.Itoa(n)
+		base := slug
+		if r.MaxLength > 0 {
+			base = r.truncate(strings.Split(slug, sep), r.MaxLength-len(suffix))
+		}
+		candidate = base + suffix
+	}
+	return "", fmt.Errorf("no unique slug found for %q after %d attempts", slug, maxUniqueAttempts)
+}
+
+// separator returns the separator, or the default
+func (r SlugRules) separator() string {
+	if r.Separator == "" {
+		return "-"
+	}
+	return r.Separator
+}
+
+// isStopWord selects the tokens that are stop words
+func (r SlugRules) isStopWord(t Tokens) []int {
+	var ret []int
+	for i, s := range t {
+		if r.StopWords.Has(s) {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
+
+// truncate joins as many whole tokens as fit in max bytes, or if not even the first fits, as much of it as does
+func (r SlugRules) truncate(t Tokens, max int) string {
+	sep := r.separator()
+	joined := t.Join(sep)
+	if r.MaxLength <= 0 || len(joined) <= max {
+		return joined
+	}
+	if max <= 0 {
+		return ""
+	}
+
+	words := make(Tokens, 0, len(t))
+	for _, w := range t {
+		if w != "" { // eg from a slug given to Unique with a leading separator
+			words = append(words, w)
+		}
+	}
+	if len(words) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, w := range words {
+		add := len(w)
+		if b.Len() > 0 {
+			add += len(sep)
+		}
+		if b.Len()+add > max {
+			break
+		}
+		if b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(w)
+	}
+	if b.Len() == 0 {
+		cut := max
+		for cut > 0 && !utf8.RuneStart(words[0][cut]) {
+			cut--
+		}
+		return words[0][:cut]
+	}
+	return b.String()
+}
@@ -0,0 +1,126 @@
+package wordcase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSlug provides unit test coverage for Slug()
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "", want: ""},
+		{s: "Hello, World!", want: "hello-world"},
+		{s: "Crème Brûlée über Straße", want: "creme-brulee-uber-strasse"},
+		{s: "Don't Panic", want: "dont-panic"},
+		{s: "It’s here", want: "its-here"},
+		{s: "  a---b  ", want: "a-b"},
+		{s: "iPhone 15 Pro", want: "iphone-15-pro"},
+		{s: "Москва и Ελλάδα", want: "moskva-i-ellada"},
+		{s: "日本語 text", want: "text"},
+		{s: "日本語", want: ""},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			got := Slug(tt.s)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, got, Slug(got), "idempotent")
+		})
+	}
+}
+
+// TestSlugRules provides unit test coverage for SlugRules.Style()
+func TestSlugRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules SlugRules
+		s     string
+		want  string
+	}{
+		{
+			name:  "max length on a word boundary",
+			rules: SlugRules{MaxLength: 12},
+			s:     "The quick brown fox",
+			want:  "the-quick",
+		},
+		{
+			name:  "max length exactly",
+			rules: SlugRules{MaxLength: 15},
+			s:     "The quick brown fox",
+			want:  "the-quick-brown",
+		},
+		{
+			name:  "max length inside the first word",
+			rules: SlugRules{MaxLength: 5},
+			s:     "Supercalifragilistic",
+			want:  "super",
+		},
+		{
+			name:  "stop words",
+			rules: SlugRules{StopWords: NewWordSet(EnglishStopWords...)},
+			s:     "The Lord of the Rings",
+			want:  "lord-rings",
+		},
+		{
+			name:  "only stop words",
+			rules: SlugRules{StopWords: NewWordSet(EnglishStopWords...)},
+			s:     "To Be Or",
+			want:  "to-be-or",
+		},
+		{
+			name:  "separator",
+			rules: SlugRules{Separator: "_", MaxLength: 9},
+			s:     "Crème Brûlée",
+			want:  "creme",
+		},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.rules.Style()(tt.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestUniqueSlug provides unit test coverage for UniqueSlug() and SlugRules.Unique()
+func TestUniqueSlug(t *testing.T) {
+	taken := NewWordSet("hello-world", "hello-world-2", "hello-2", "hello-3", "hello-4")
+	exists := func(s string) (bool, error) { return taken.Has(s), nil }
+
+	got, err := UniqueSlug("new-post", exists)
+	assert.NoError(t, err)
+	assert.Equal(t, "new-post", got)
+
+	got, err = UniqueSlug("hello-world", exists)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello-world-3", got)
+
+	got, err = SlugRules{MaxLength: 11}.Unique("hello-world", exists)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello-5", got, "shortened to fit the number")
+
+	taken = NewWordSet("-hello-world", "--abcdef")
+	got, err = SlugRules{MaxLength: 11}.Unique("-hello-world", exists)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello-2", got, "empty words aren't kept when shortened")
+
+	got, err = SlugRules{MaxLength: 4}.Unique("--abcdef", exists)
+	assert.NoError(t, err)
+	assert.Equal(t, "ab-2", got, "the first word that isn't empty is cut")
+
+	_, err = UniqueSlug("x", func(string) (bool, error) { return false, errors.New("db down") })
+	assert.EqualError(t, err, "db down")
+
+	_, err = UniqueSlug("x", func(string) (bool, error) { return true, nil })
+	assert.EqualError(t, err, `no unique slug found for "x" after 10000 attempts`)
+}
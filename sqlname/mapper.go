@@ -0,0 +1,131 @@
+package sqlname
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Field is a struct field mapped to a column
+type Field struct {
+	Column string // the column name, truncated but not quoted
+	Path   string // the path to the field, eg "Audit.CreatedAt" for a field of an embedded struct
+	Index  []int  // the index sequence of the field, for reflect.Value.FieldByIndex
+}
+
+// Mapper maps between the fields of a struct type and columns
+type Mapper struct {
+	Type    reflect.Type
+	dialect Dialect
+	fields  []Field
+	byKey   map[string]int
+}
+
+// NewMapper creates a mapper for the fields of a struct type, given a struct or pointer to one.
+//
+//	Exported fields are mapped to columns named with ColumnName, unless they have a `db` tag giving the column name,
+//	or "-" to skip the field. The fields of embedded structs are mapped as if they were part of the outer struct
+func NewMapper(v any, d Dialect) (*Mapper, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct or pointer to a struct, got %T", v)
+	}
+
+	m := &Mapper{Type: t, dialect: d, byKey: make(map[string]int)}
+	if err := m.walk(t, nil, nil); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// walk maps the fields of the struct type t
+func (m *Mapper) walk(t reflect.Type, index []int, path []string) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("db")
+		if tag == "-" {
+			continue
+		}
+		fieldIndex := append(index[:len(index):len(index)], i)
+		fieldPath := append(path[:len(path):len(path)], f.Name)
+
+		if f.Anonymous && tag == "" && f.Type.Kind() == reflect.Struct {
+			if err := m.walk(f.Type, fieldIndex, fieldPath); err != nil {
+				return err
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		column := tag
+		if column == "" {
+			column = ColumnName(f.Name)
+		}
+		fl := Field{
+			Column: m.dialect.Truncate(column),
+			Path:   strings.Join(fieldPath, "."),
+			Index:  fieldIndex,
+		}
+		key := columnKey(fl.Column)
+		if prev, ok := m.byKey[key]; ok {
+			return fmt.Errorf("fields %s and %s both map to the column %s", m.fields[prev].Path, fl.Path, fl.Column)
+		}
+		m.byKey[key] = len(m.fields)
+		m.fields = append(m.fields, fl)
+	}
+	return nil
+}
+
+// columnKey is the canonical form of a column name, so columns are found regardless of case, or the style they're
+// written in, eg "user_id", "USER_ID" and "UserId"
+func columnKey(column string) string {
+	return ColumnName(column)
+}
+
+// Fields returns the mapped fields, in the order they're declared
+func (m *Mapper) Fields() []Field {
+	return m.fields
+}
+
+// Columns returns the identifiers of the columns of the mapped fields, quoted as needed, eg for a SELECT list
+func (m *Mapper) Columns() []string {
+	ret := make([]string, len(m.fields))
+	for i, f := range m.fields {
+		ret[i] = m.dialect.Identifier(f.Column)
+	}
+	return ret
+}
+
+// Field finds the field mapped to a column, as returned by the database (eg from sql.Rows.Columns)
+func (m *Mapper) Field(column string) (Field, bool) {
+	i, ok := m.byKey[columnKey(column)]
+	if !ok {
+		return Field{}, false
+	}
+	return m.fields[i], true
+}
+
+// ScanTargets returns pointers to the fields of the struct pointed to by dst for each of the columns, in order,
+// for passing to sql.Rows.Scan
+func (m *Mapper) ScanTargets(dst any, columns []string) ([]any, error) {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Type() != m.Type {
+		return nil, fmt.Errorf("expected a pointer to %s, got %T", m.Type, dst)
+	}
+	v = v.Elem()
+
+	ret := make([]any, len(columns))
+	for i, c := range columns {
+		f, ok := m.Field(c)
+		if !ok {
+			return nil, fmt.Errorf("no field of %s for the column %s", m.Type, c)
+		}
+		ret[i] = v.FieldByIndex(f.Index).Addr().Interface()
+	}
+	return ret, nil
+}
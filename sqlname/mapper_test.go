@@ -0,0 +1,117 @@
+package sqlname
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testAudit struct {
+	CreatedAt time.Time
+	UpdatedBy string
+}
+
+type testUser struct {
+	testAudit
+	UserID   int64
+	Name     string `db:"display_name"`
+	Order    int
+	RoleIDs  []byte
+	Password string `db:"-"`
+	internal string
+}
+
+// TestNewMapper provides unit test coverage for NewMapper()
+func TestNewMapper(t *testing.T) {
+	m, err := NewMapper(&testUser{}, Postgres)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	assert.Equal(t, []Field{
+		{Column: "created_at", Path: "testAudit.CreatedAt", Index: []int{0, 0}},
+		{Column: "updated_by", Path: "testAudit.UpdatedBy", Index: []int{0, 1}},
+		{Column: "user_id", Path: "UserID", Index: []int{1}},
+		{Column: "display_name", Path: "Name", Index: []int{2}},
+		{Column: "order", Path: "Order", Index: []int{3}},
+		{Column: "role_ids", Path: "RoleIDs", Index: []int{4}},
+	}, m.Fields())
+	assert.Equal(t, []string{"created_at", "updated_by", "user_id", "display_name", `"order"`, "role_ids"}, m.Columns())
+
+	_, err = NewMapper(3, Postgres)
+	assert.EqualError(t, err, "expected a struct or pointer to a struct, got int")
+
+	_, err = NewMapper(struct {
+		UserID int
+		UserId int
+	}{}, Postgres)
+	assert.EqualError(t, err, "fields UserID and UserId both map to the column user_id")
+
+	type long struct {
+		AVeryLongFieldNameThatGoesOnAndOnAndOnPastTheLimitForIdentifiersInPostgres string
+	}
+	m, err = NewMapper(long{}, Postgres)
+	assert.NoError(t, err)
+	assert.Len(t, m.Fields()[0].Column, 63)
+	_, ok := m.Field(m.Fields()[0].Column)
+	assert.True(t, ok, "found by the truncated name")
+}
+
+// TestMapperField provides unit test coverage for Mapper.Field()
+func TestMapperField(t *testing.T) {
+	m, err := NewMapper(testUser{}, MySQL)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	tests := []struct {
+		column string
+		want   string
+		wantOK bool
+	}{
+		{column: "user_id", want: "UserID", wantOK: true},
+		{column: "USER_ID", want: "UserID", wantOK: true},
+		{column: "UserId", want: "UserID", wantOK: true},
+		{column: "display_name", want: "Name", wantOK: true},
+		{column: "name", wantOK: false},
+		{column: "password", wantOK: false},
+		{column: "created_at", want: "testAudit.CreatedAt", wantOK: true},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.column, func(t *testing.T) {
+			t.Parallel()
+			got, ok := m.Field(tt.column)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got.Path)
+		})
+	}
+}
+
+// TestMapperScanTargets provides unit test coverage for Mapper.ScanTargets()
+func TestMapperScanTargets(t *testing.T) {
+	m, err := NewMapper(testUser{}, SQLite)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	var u testUser
+	targets, err := m.ScanTargets(&u, []string{"display_name", "USER_ID", "created_at"})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	*targets[0].(*string) = "Ann"
+	*targets[1].(*int64) = 7
+	*targets[2].(*time.Time) = time.Unix(0, 0)
+	assert.Equal(t, "Ann", u.Name)
+	assert.Equal(t, int64(7), u.UserID)
+	assert.Equal(t, time.Unix(0, 0), u.CreatedAt)
+
+	_, err = m.ScanTargets(&u, []string{"password"})
+	assert.EqualError(t, err, "no field of sqlname.testUser for the column password")
+
+	_, err = m.ScanTargets(u, nil)
+	assert.True(t, strings.HasPrefix(err.Error(), "expected a pointer to sqlname.testUser"))
+}
@@ -0,0 +1,101 @@
+package sqlname
+
+import (
+	"strings"
+
+	"github.com/mantidtech/wordcase"
+)
+
+// irregularPlurals are the plurals of words that don't follow the usual rules
+var irregularPlurals = map[string]string{
+	"child":  "children",
+	"foot":   "feet",
+	"goose":  "geese",
+	"man":    "men",
+	"mouse":  "mice",
+	"ox":     "oxen",
+	"person": "people",
+	"tooth":  "teeth",
+	"woman":  "women",
+}
+
+// uncountable are words that are the same when plural
+var uncountable = wordcase.NewWordSet(
+	"audio", "data", "equipment", "feedback", "fish", "information", "metadata", "money", "news", "police", "series",
+	"sheep", "species", "staff",
+)
+
+// fPlurals are words ending in "f" or "fe" that end in "ves" when plural
+var fPlurals = wordcase.NewWordSet(
+	"calf", "elf", "half", "knife", "leaf", "life", "loaf", "self", "shelf", "thief", "wife", "wolf",
+)
+
+// singularS are words ending in "s" that aren't plural, other than those ending in "ss", "us" or "is"
+var singularS = wordcase.NewWordSet(
+	"alias", "atlas", "bias", "canvas", "chaos", "gas", "lens", "pancreas", "yes",
+)
+
+// oPlurals are words ending in "o" that end in "oes" when plural
+var oPlurals = wordcase.NewWordSet("echo", "hero", "potato", "tomato", "veto")
+
+// Pluralize returns the plural of a lowercase English word, eg "address" -> "addresses", "category" -> "categories".
+// Words that already look plural are returned unchanged, eg "users".
+//
+//	The rules are simple and only know a few irregular words: any other word ending in "s" is taken to be plural,
+//	unless it ends in "ss", "us" or "is", so for names outside the lists (eg "thesaurus" -> "thesauruses") check the
+//	result, or give the table name explicitly
+func Pluralize(word string) string {
+	if p, ok := irregularPlurals[word]; ok {
+		return p
+	}
+	if uncountable.Has(word) || isPlural(word) {
+		return word
+	}
+
+	switch {
+	case fPlurals.Has(word) && strings.HasSuffix(word, "fe"):
+		return strings.TrimSuffix(word, "fe") + "ves"
+	case fPlurals.Has(word):
+		return strings.TrimSuffix(word, "f") + "ves"
+	case oPlurals.Has(word):
+		return word + "es"
+	case strings.HasSuffix(word, "is"):
+		return strings.TrimSuffix(word, "is") + "es" // eg analysis -> analyses
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsAny(word[len(word)-2:len(word)-1], "aeiou"):
+		return strings.TrimSuffix(word, "y") + "ies"
+	case doublesZ(word):
+		return word + "zes" // eg quiz -> quizzes
+	case strings.HasSuffix(word, "s"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "z"),
+		strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"):
+		return word + "es"
+	default:
+		return word + "s"
+	}
+}
+
+// isPlural returns true for words that already look plural
+func isPlural(word string) bool {
+	for _, p := range irregularPlurals {
+		if p == word {
+			return true
+		}
+	}
+	return strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us") &&
+		!strings.HasSuffix(word, "is") && !singularS.Has(word)
+}
+
+// doublesZ returns true for words of one syllable ending in a single vowel and "z", whose "z" is doubled when plural,
+// eg "quiz", "fez", but not "waltz" or "topaz"
+func doublesZ(word string) bool {
+	n := len(word)
+	if n < 3 || word[n-1] != 'z' || !isVowel(word[n-2]) {
+		return false
+	}
+	head := strings.Replace(word[:n-2], "qu", "q", 1) // the "u" of "qu" isn't a vowel of its own
+	return head != "" && !strings.ContainsAny(head, "aeiou")
+}
+
+// isVowel returns true for the letters a, e, i, o and u
+func isVowel(b byte) bool {
+	return strings.IndexByte("aeiou", b) >= 0
+}
@@ -0,0 +1,53 @@
+package sqlname
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPluralize provides unit test coverage for Pluralize()
+func TestPluralize(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{word: "user", want: "users"},
+		{word: "users", want: "users"},
+		{word: "address", want: "addresses"},
+		{word: "status", want: "statuses"},
+		{word: "box", want: "boxes"},
+		{word: "match", want: "matches"},
+		{word: "wish", want: "wishes"},
+		{word: "category", want: "categories"},
+		{word: "day", want: "days"},
+		{word: "analysis", want: "analyses"},
+		{word: "hero", want: "heroes"},
+		{word: "photo", want: "photos"},
+		{word: "knife", want: "knives"},
+		{word: "shelf", want: "shelves"},
+		{word: "roof", want: "roofs"},
+		{word: "person", want: "people"},
+		{word: "people", want: "people"},
+		{word: "child", want: "children"},
+		{word: "data", want: "data"},
+		{word: "series", want: "series"},
+		{word: "gas", want: "gases"},
+		{word: "bus", want: "buses"},
+		{word: "alias", want: "aliases"},
+		{word: "ideas", want: "ideas"},
+		{word: "quiz", want: "quizzes"},
+		{word: "fez", want: "fezzes"},
+		{word: "waltz", want: "waltzes"},
+		{word: "topaz", want: "topazes"},
+		{word: "buzz", want: "buzzes"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.word, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, Pluralize(tt.word))
+		})
+	}
+}
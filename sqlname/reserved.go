@@ -0,0 +1,32 @@
+package sqlname
+
+// postgresReservedWords are words reserved by PostgreSQL, in addition to wordcase.SQLReservedWords
+var postgresReservedWords = []string{
+	"analyse", "analyze", "array", "asymmetric", "authorization", "binary", "both", "collate", "collation",
+	"concurrently", "current_catalog", "current_role", "current_schema", "deferrable", "do", "freeze", "ilike",
+	"initially", "isnull", "lateral", "leading", "localtime", "localtimestamp", "notnull", "only", "overlaps",
+	"placing", "returning", "similar", "symmetric", "tablesample", "trailing", "variadic", "verbose", "window",
+}
+
+// mySQLReservedWords are words reserved by MySQL, in addition to wordcase.SQLReservedWords
+var mySQLReservedWords = []string{
+	"accessible", "add", "before", "both", "call", "cascade", "change", "char", "condition", "continue", "database",
+	"databases", "dec", "decimal", "declare", "delayed", "describe", "div", "double", "each", "elseif", "enclosed",
+	"escaped", "exit", "explain", "float", "force", "fulltext", "generated", "groups", "high_priority", "if",
+	"ignore", "index", "infile", "int", "integer", "interval", "key", "keys", "kill", "leading", "leave", "lines",
+	"load", "lock", "long", "loop", "match", "mod", "modifies", "option", "optionally", "out", "outfile",
+	"partition", "procedure", "range", "rank", "read", "regexp", "release", "rename", "repeat", "replace", "require",
+	"restrict", "return", "revoke", "rlike", "row", "rows", "schema", "schemas", "separator", "show", "signal",
+	"spatial", "sql", "ssl", "starting", "stored", "straight_join", "system", "terminated", "trigger", "undo",
+	"unlock", "unsigned", "usage", "use", "varchar", "virtual", "while", "write", "xor", "zerofill",
+}
+
+// sqliteReservedWords are words reserved by SQLite, in addition to wordcase.SQLReservedWords
+var sqliteReservedWords = []string{
+	"abort", "action", "add", "after", "analyze", "attach", "autoincrement", "before", "begin", "cascade", "collate",
+	"commit", "conflict", "database", "deferrable", "deferred", "detach", "each", "escape", "exclusive", "explain",
+	"fail", "glob", "if", "ignore", "immediate", "index", "indexed", "initially", "instead", "isnull", "key",
+	"notnull", "of", "plan", "pragma", "query", "raise", "recursive", "regexp", "reindex", "release", "rename",
+	"replace", "restrict", "rollback", "row", "rows", "savepoint", "temp", "temporary", "transaction", "trigger",
+	"vacuum", "view", "virtual", "window", "without",
+}
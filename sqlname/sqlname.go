@@ -0,0 +1,117 @@
+// Package sqlname maps Go names to SQL table and column names, quoting and truncating them for a database dialect,
+// and maps result columns back to struct fields for scanning rows
+package sqlname
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mantidtech/wordcase"
+)
+
+// Dialect describes the identifiers of a database
+type Dialect struct {
+	Name      string
+	Reserved  wordcase.WordSet   // lowercase reserved words, which must be quoted to be used as identifiers
+	Quote     wordcase.Formatter // quotes an identifier
+	MaxLength int                // the maximum length of an identifier in bytes, or 0 for no limit
+}
+
+// Postgres is the dialect of PostgreSQL, where identifiers are limited to 63 bytes
+var Postgres = Dialect{
+	Name:      "postgres",
+	Reserved:  wordcase.NewWordSet(append(postgresReservedWords, wordcase.SQLReservedWords...)...),
+	Quote:     wordcase.DoubleQuote,
+	MaxLength: 63,
+}
+
+// MySQL is the dialect of MySQL and MariaDB, where identifiers are limited to 64 bytes
+var MySQL = Dialect{
+	Name:      "mysql",
+	Reserved:  wordcase.NewWordSet(append(mySQLReservedWords, wordcase.SQLReservedWords...)...),
+	Quote:     wordcase.BacktickQuote,
+	MaxLength: 64,
+}
+
+// SQLite is the dialect of SQLite, which has no limit on the length of identifiers
+var SQLite = Dialect{
+	Name:     "sqlite",
+	Reserved: wordcase.NewWordSet(append(sqliteReservedWords, wordcase.SQLReservedWords...)...),
+	Quote:    wordcase.DoubleQuote,
+}
+
+// hashLength is the length of the hash added to truncated identifiers, including its separator
+const hashLength = 9
+
+// ColumnName converts a struct field name into a column name, eg "UserID" -> "user_id", "RoleIDs" -> "role_ids"
+func ColumnName(field string) string {
+	return wordcase.PluralSafeSnakeCase(field)
+}
+
+// TableName converts a type name into a table name, pluralising the last word, eg "UserAddress" -> "user_addresses"
+func TableName(typeName string) string {
+	t := wordcase.PluralSafeTokenizer(typeName).FormatAll(strings.ToLower)
+	if len(t) == 0 {
+		return ""
+	}
+	t[len(t)-1] = Pluralize(t[len(t)-1])
+	return t.Join("_")
+}
+
+// Column returns the identifier for the column of a struct field, truncated and quoted as needed
+func (d Dialect) Column(field string) string {
+	return d.Identifier(ColumnName(field))
+}
+
+// Table returns the identifier for the table of a type, truncated and quoted as needed
+func (d Dialect) Table(typeName string) string {
+	return d.Identifier(TableName(typeName))
+}
+
+// Identifier truncates the name if it's too long, then quotes it if it needs to be
+func (d Dialect) Identifier(name string) string {
+	name = d.Truncate(name)
+	if d.NeedsQuote(name) {
+		return d.Quote(name)
+	}
+	return name
+}
+
+// IsReserved returns true if the name is a reserved word, regardless of case
+func (d Dialect) IsReserved(name string) bool {
+	return d.Reserved.Has(strings.ToLower(name))
+}
+
+// NeedsQuote returns true if the name can't be used as an identifier without quoting:
+// it's reserved, or isn't made of lowercase letters, digits and underscores (not starting with a digit)
+func (d Dialect) NeedsQuote(name string) bool {
+	if name == "" || d.IsReserved(name) {
+		return true
+	}
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r == '_':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Truncate shortens a name longer than the dialect's limit, replacing the end with a hash of the whole name so
+// names that share a long prefix stay distinct, eg "..._created_at" -> "..._cr_1a2b3c4d"
+func (d Dialect) Truncate(name string) string {
+	if d.MaxLength <= hashLength || len(name) <= d.MaxLength {
+		return name
+	}
+	keep := d.MaxLength - hashLength
+	for keep > 0 && !utf8.RuneStart(name[keep]) {
+		keep--
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return fmt.Sprintf("%s_%08x", name[:keep], h.Sum32())
+}
@@ -0,0 +1,109 @@
+package sqlname
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestColumnName provides unit test coverage for ColumnName()
+func TestColumnName(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{field: "UserID", want: "user_id"},
+		{field: "RoleIDs", want: "role_ids"},
+		{field: "CreatedAt", want: "created_at"},
+		{field: "HTTPStatus", want: "http_status"},
+		{field: "CPU Is Busy", want: "cpu_is_busy"},
+		{field: "ID_As", want: "id_as"},
+		{field: "", want: ""},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.field, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, ColumnName(tt.field))
+		})
+	}
+}
+
+// TestTableName provides unit test coverage for TableName()
+func TestTableName(t *testing.T) {
+	tests := []struct {
+		typeName string
+		want     string
+	}{
+		{typeName: "User", want: "users"},
+		{typeName: "UserAddress", want: "user_addresses"},
+		{typeName: "OrderCategory", want: "order_categories"},
+		{typeName: "Person", want: "people"},
+		{typeName: "Metadata", want: "metadata"},
+		{typeName: "APIKey", want: "api_keys"},
+		{typeName: "A Bs", want: "a_bs"},
+		{typeName: "ID_Address", want: "id_addresses"},
+		{typeName: "", want: ""},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.typeName, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, TableName(tt.typeName))
+		})
+	}
+}
+
+// TestDialectIdentifier provides unit test coverage for Dialect.Identifier(), Dialect.Column() and Dialect.Table()
+func TestDialectIdentifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		fn      func(Dialect, string) string
+		s       string
+		want    string
+	}{
+		{name: "plain column", dialect: Postgres, fn: Dialect.Column, s: "UserID", want: "user_id"},
+		{name: "reserved column postgres", dialect: Postgres, fn: Dialect.Column, s: "User", want: `"user"`},
+		{name: "reserved column mysql", dialect: MySQL, fn: Dialect.Column, s: "Key", want: "`key`"},
+		{name: "not reserved in postgres", dialect: Postgres, fn: Dialect.Column, s: "Key", want: "key"},
+		{name: "reserved column sqlite", dialect: SQLite, fn: Dialect.Column, s: "Transaction", want: `"transaction"`},
+		{name: "reserved table", dialect: Postgres, fn: Dialect.Table, s: "Order", want: "orders"},
+		{name: "reserved table singular", dialect: SQLite, fn: Dialect.Identifier, s: "order", want: `"order"`},
+		{name: "mixed case", dialect: Postgres, fn: Dialect.Identifier, s: "UserID", want: `"UserID"`},
+		{name: "leading digit", dialect: Postgres, fn: Dialect.Identifier, s: "2fa", want: `"2fa"`},
+		{name: "embedded quote", dialect: Postgres, fn: Dialect.Identifier, s: `a"b`, want: `"a""b"`},
+		{name: "empty", dialect: Postgres, fn: Dialect.Identifier, s: "", want: `""`},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.fn(tt.dialect, tt.s))
+		})
+	}
+}
+
+// TestDialectTruncate provides unit test coverage for Dialect.Truncate()
+func TestDialectTruncate(t *testing.T) {
+	long := strings.Repeat("abcdefgh_", 8) // 72 bytes
+
+	got := Postgres.Truncate(long)
+	assert.Len(t, got, 63)
+	assert.True(t, strings.HasPrefix(got, long[:54]+"_"))
+	assert.Equal(t, got, Postgres.Truncate(long), "stable")
+	assert.NotEqual(t, got, Postgres.Truncate(long+"x"), "distinct names stay distinct")
+
+	assert.Len(t, MySQL.Truncate(long), 64)
+	assert.Equal(t, long, SQLite.Truncate(long))
+	assert.Equal(t, "short", Postgres.Truncate("short"))
+
+	multi := strings.Repeat("é", 40) // 80 bytes, 2 per rune
+	got = Postgres.Truncate(multi)
+	assert.Equal(t, strings.Repeat("é", 27)+got[54:], got, "doesn't split a rune")
+	assert.Len(t, got, 63)
+}
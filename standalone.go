@@ -4,6 +4,13 @@ import (
 	"strings"
 )
 
+// Tokenizer breaks a string into tokens like the standalone methods below, except that it only splits at case changes
+// (see CaseChangeCategorizer), so an initialism followed by a digit stays whole, eg "UTF8Decoder" -> "UTF8", "Decoder"
+// where SnakeCase gives "ut_f8_decoder"
+var Tokenizer = NewPipeline().
+	TokenizeUsing(LookAroundCategorizer, NotLetterOrDigit, true).
+	TokenizeUsing(CaseChangeCategorizer, NotLowerOrDigit, false)
+
 // SnakeCase concatenates tokens into a string separated by underscores
 var SnakeCase = NewPipeline().
 	TokenizeUsing(LookAroundCategorizer, NotLetterOrDigit, true).
@@ -65,3 +72,13 @@ var TitleCase = NewPipeline().
 	WithFormatter(strings.ToUpper, LintWords).
 	WithAllFormatter(UppercaseFirst).
 	JoinWith(" ")
+
+// SentenceCase creates a string from tokens by making them lowercase (except initialisms), with the first rune of the
+// first token uppercase, and joining them with spaces
+var SentenceCase = NewPipeline().
+	TokenizeUsing(LookAroundCategorizer, NotLetterOrDigit, true).
+	TokenizeUsing(LookAroundCategorizer, NotLowerOrDigit, false).
+	WithAllFormatter(strings.ToLower).
+	WithFormatter(strings.ToUpper, LintWords).
+	WithFormatter(UppercaseFirst, ToFirst).
+	JoinWith(" ")
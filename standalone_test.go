@@ -1,6 +1,9 @@
 package wordcase
 
 import (
+	"math/rand"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,6 +15,7 @@ const (
 	testKebabCase          = "kebab-case"
 	testPascalCase         = "PascalCase"
 	testScreamingSnakeCase = "SCREAMING_SNAKE_CASE"
+	testSentenceCase       = "Sentence case"
 	testSnakeCase          = "snake_case"
 	testTitleCase          = "Title Case"
 	testWordCase           = "word case"
@@ -23,6 +27,7 @@ var testFunctions = []string{
 	testKebabCase,
 	testPascalCase,
 	testScreamingSnakeCase,
+	testSentenceCase,
 	testSnakeCase,
 	testTitleCase,
 	testWordCase,
@@ -37,6 +42,7 @@ var fnMap = map[string]func(string) string{
 	testPascalCase:         PascalCase,
 	testWordCase:           Words,
 	testTitleCase:          TitleCase,
+	testSentenceCase:       SentenceCase,
 }
 
 var testCases = map[string]map[string]string{
@@ -46,6 +52,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "a",
 		testKebabCase:          "a",
 		testScreamingSnakeCase: "A",
+		testSentenceCase:       "A",
 		testSnakeCase:          "a",
 		testTitleCase:          "A",
 		testWordCase:           "a",
@@ -56,6 +63,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "a",
 		testKebabCase:          "a",
 		testScreamingSnakeCase: "A",
+		testSentenceCase:       "A",
 		testSnakeCase:          "a",
 		testTitleCase:          "A",
 		testWordCase:           "A",
@@ -66,6 +74,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "a.b",
 		testKebabCase:          "a-b",
 		testScreamingSnakeCase: "A_B",
+		testSentenceCase:       "A b",
 		testSnakeCase:          "a_b",
 		testTitleCase:          "A B",
 		testWordCase:           "a b",
@@ -76,6 +85,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "a.b",
 		testKebabCase:          "a-b",
 		testScreamingSnakeCase: "A_B",
+		testSentenceCase:       "A b",
 		testSnakeCase:          "a_b",
 		testTitleCase:          "A B",
 		testWordCase:           "a B",
@@ -86,6 +96,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "dooker",
 		testKebabCase:          "dooker",
 		testScreamingSnakeCase: "DOOKER",
+		testSentenceCase:       "Dooker",
 		testSnakeCase:          "dooker",
 		testTitleCase:          "Dooker",
 		testWordCase:           "dooker",
@@ -93,6 +104,7 @@ var testCases = map[string]map[string]string{
 	"dookerSpam99_rawr": {
 		testCamelCase:          "dookerSpam99Rawr",
 		testScreamingSnakeCase: "DOOKER_SPAM99_RAWR",
+		testSentenceCase:       "Dooker spam99 rawr",
 		testDotCase:            "dooker.spam99.rawr",
 		testKebabCase:          "dooker-spam99-rawr",
 		testPascalCase:         "DookerSpam99Rawr",
@@ -106,6 +118,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "id.one.xml.http.on",
 		testKebabCase:          "id-one-xml-http-on",
 		testScreamingSnakeCase: "ID_ONE_XML_HTTP_ON",
+		testSentenceCase:       "ID one XML HTTP on",
 		testSnakeCase:          "id_one_xml_http_on",
 		testTitleCase:          "ID One XML HTTP On",
 		testWordCase:           "ID One XML HTTP ON",
@@ -116,6 +129,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "max.id",
 		testKebabCase:          "max-id",
 		testScreamingSnakeCase: "MAX_ID",
+		testSentenceCase:       "Max ID",
 		testSnakeCase:          "max_id",
 		testTitleCase:          "Max ID",
 		testWordCase:           "max ID",
@@ -126,6 +140,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "max.id",
 		testKebabCase:          "max-id",
 		testScreamingSnakeCase: "MAX_ID",
+		testSentenceCase:       "Max ID",
 		testSnakeCase:          "max_id",
 		testTitleCase:          "Max ID",
 		testWordCase:           "max ID",
@@ -136,6 +151,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "env.var",
 		testKebabCase:          "env-var",
 		testScreamingSnakeCase: "ENV_VAR",
+		testSentenceCase:       "Env var",
 		testSnakeCase:          "env_var",
 		testTitleCase:          "Env Var",
 		testWordCase:           "ENV VAR",
@@ -146,6 +162,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "snake.case",
 		testKebabCase:          "snake-case",
 		testScreamingSnakeCase: "SNAKE_CASE",
+		testSentenceCase:       "Snake case",
 		testSnakeCase:          "snake_case",
 		testTitleCase:          "Snake Case",
 		testWordCase:           "snake case",
@@ -156,6 +173,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "kebab.case",
 		testKebabCase:          "kebab-case",
 		testScreamingSnakeCase: "KEBAB_CASE",
+		testSentenceCase:       "Kebab case",
 		testSnakeCase:          "kebab_case",
 		testTitleCase:          "Kebab Case",
 		testWordCase:           "kebab case",
@@ -166,6 +184,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "id.one",
 		testKebabCase:          "id-one",
 		testScreamingSnakeCase: "ID_ONE",
+		testSentenceCase:       "ID one",
 		testSnakeCase:          "id_one",
 		testTitleCase:          "ID One",
 		testWordCase:           "ID One",
@@ -176,6 +195,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "99two",
 		testKebabCase:          "99two",
 		testScreamingSnakeCase: "99TWO",
+		testSentenceCase:       "99two",
 		testSnakeCase:          "99two",
 		testTitleCase:          "99two",
 		testWordCase:           "99two",
@@ -186,6 +206,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "99.two",
 		testKebabCase:          "99-two",
 		testScreamingSnakeCase: "99_TWO",
+		testSentenceCase:       "99 two",
 		testSnakeCase:          "99_two",
 		testTitleCase:          "99 Two",
 		testWordCase:           "99 Two",
@@ -196,6 +217,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "interface",
 		testKebabCase:          "interface",
 		testScreamingSnakeCase: "INTERFACE",
+		testSentenceCase:       "Interface",
 		testSnakeCase:          "interface",
 		testTitleCase:          "Interface",
 		testWordCase:           "interface",
@@ -206,6 +228,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "something",
 		testKebabCase:          "something",
 		testScreamingSnakeCase: "SOMETHING",
+		testSentenceCase:       "Something",
 		testSnakeCase:          "something",
 		testTitleCase:          "Something",
 		testWordCase:           "something",
@@ -216,6 +239,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "something",
 		testKebabCase:          "something",
 		testScreamingSnakeCase: "SOMETHING",
+		testSentenceCase:       "Something",
 		testSnakeCase:          "something",
 		testTitleCase:          "Something",
 		testWordCase:           "something",
@@ -226,6 +250,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "prefixed",
 		testKebabCase:          "prefixed",
 		testScreamingSnakeCase: "PREFIXED",
+		testSentenceCase:       "Prefixed",
 		testSnakeCase:          "prefixed",
 		testTitleCase:          "Prefixed",
 		testWordCase:           "prefixed",
@@ -236,6 +261,7 @@ var testCases = map[string]map[string]string{
 		testDotCase:            "prefixed",
 		testKebabCase:          "prefixed",
 		testScreamingSnakeCase: "PREFIXED",
+		testSentenceCase:       "Prefixed",
 		testSnakeCase:          "prefixed",
 		testTitleCase:          "Prefixed",
 		testWordCase:           "prefixed",
@@ -258,3 +284,237 @@ func TestStandAloneMethods(t *testing.T) {
 		}
 	}
 }
+
+// refTokenizer is a reference implementation of the tokenizer used by the standalone methods, written with regular
+// expressions. Runs of runes that aren't letters or digits separate tokens, then within those a run of "not lower or
+// digit" runes is split off from its last rune if that rune starts a lowercase/digit run (eg "IDOne" -> "ID", "One")
+var refTokenizer = NewPipeline().
+	SplitRegexp(regexp.MustCompile(`[^\pL\p{Nd}]+`), true).
+	TokenizeRegexp(regexp.MustCompile(`([^\p{Ll}\p{Nd}]*)([^\p{Ll}\p{Nd}][\p{Ll}\p{Nd}]+)|([^\p{Ll}\p{Nd}]+)|([\p{Ll}\p{Nd}]+)`), false)
+
+var refFnMap = map[string]func(string) string{
+	testSnakeCase:          refTokenizer.WithAllFormatter(strings.ToLower).JoinWith("_"),
+	testKebabCase:          refTokenizer.WithAllFormatter(strings.ToLower).JoinWith("-"),
+	testDotCase:            refTokenizer.WithAllFormatter(strings.ToLower).JoinWith("."),
+	testScreamingSnakeCase: refTokenizer.WithAllFormatter(strings.ToUpper).JoinWith("_"),
+	testCamelCase: refTokenizer.
+		WithAllFormatter(strings.ToLower).
+		WithFormatter(UppercaseFirst, ToRest).
+		WithFormatter(strings.ToUpper, And(ToRest, LintWords)).
+		JoinWith(""),
+	testPascalCase: refTokenizer.
+		WithAllFormatter(strings.ToLower).
+		WithAllFormatter(UppercaseFirst).
+		WithFormatter(strings.ToUpper, LintWords).
+		JoinWith(""),
+	testWordCase: refTokenizer.WithFormatter(strings.ToUpper, LintWords).JoinWith(" "),
+	testTitleCase: refTokenizer.
+		WithAllFormatter(strings.ToLower).
+		WithFormatter(strings.ToUpper, LintWords).
+		WithAllFormatter(UppercaseFirst).
+		JoinWith(" "),
+	testSentenceCase: refTokenizer.
+		WithAllFormatter(strings.ToLower).
+		WithFormatter(strings.ToUpper, LintWords).
+		WithFormatter(UppercaseFirst, ToFirst).
+		JoinWith(" "),
+}
+
+// TestReferenceTokenizer cross-checks the standalone methods against the regular expression reference implementation
+func TestReferenceTokenizer(t *testing.T) {
+	inputs := []string{
+		"",
+		"XMLHTTPRequest",
+		"ÄrgerÜberÖl",
+		"_a_B_cD_",
+		"über 42 Straße",
+		"a1B2c3",
+		"UTF8Decoder",
+		"user_ID2",
+		"AB1cD",
+		"A1A_b2B",
+	}
+	for testText := range testCases {
+		inputs = append(inputs, testText)
+	}
+	for i := 0; i < 20; i++ {
+		inputs = append(inputs, helperGenTestString(100, 0.2, 0.3))
+	}
+
+	for _, testText := range inputs {
+		for _, c := range testFunctions {
+			want := fnMap[c](testText)
+			got := refFnMap[c](testText)
+			assert.Equal(t, want, got, "%s given: '%s'", c, testText)
+		}
+	}
+}
+
+// helperGenIdentifier generates a string made from words, initialisms, numbers and words containing digits,
+// in a random mix of cases and separators
+func helperGenIdentifier(r *rand.Rand) string {
+	words := []string{"user", "name", "conns", "ärger", "öl", "id", "xml", "http", "api", "utf8", "md5", "2", "42",
+		"vol2", "x1yz", "42go"}
+	cases := []func(string) string{strings.ToLower, strings.ToUpper, UppercaseFirst}
+	seps := []string{"", "", "_", "-", ".", " ", "$", "__"}
+
+	var b strings.Builder
+	n := 1 + r.Intn(5)
+	for i := 0; i < n; i++ {
+		b.WriteString(seps[r.Intn(len(seps))])
+		b.WriteString(cases[r.Intn(len(cases))](words[r.Intn(len(words))]))
+	}
+	return b.String()
+}
+
+// helperGenAmbiguous generates every string up to the given length from short pieces, including single letters,
+// digits and initialisms, which can be ambiguous once joined together
+func helperGenAmbiguous(size int) []string {
+	pieces := []string{"a", "A", "1", "_", "id", "Id", "ID", "xml", "Http"}
+	ret := []string{""}
+	from := 0
+	for l := 0; l < size; l++ {
+		to := len(ret)
+		for _, s := range ret[from:to] {
+			for _, p := range pieces {
+				ret = append(ret, s+p)
+			}
+		}
+		from = to
+	}
+	return ret
+}
+
+// notIdempotent are the styles that can change a string they've already converted (see TestStandAloneNotIdempotent)
+var notIdempotent = map[string]bool{
+	testCamelCase:          true,
+	testPascalCase:         true,
+	testScreamingSnakeCase: true,
+	testSentenceCase:       true,
+	testTitleCase:          true,
+	testWordCase:           true,
+}
+
+// helperGenIdentifiers returns random identifiers to convert
+func helperGenIdentifiers() []string {
+	r := rand.New(rand.NewSource(1))
+	inputs := make([]string, 0, 2000)
+	for i := 0; i < 2000; i++ {
+		inputs = append(inputs, helperGenIdentifier(r))
+	}
+	return inputs
+}
+
+// TestStandAloneIdempotence checks that converting a string that's already been converted doesn't change it, for
+// the styles where that holds
+func TestStandAloneIdempotence(t *testing.T) {
+	inputs := helperGenIdentifiers()
+	for _, c := range testFunctions {
+		if notIdempotent[c] {
+			continue
+		}
+		fn := fnMap[c]
+		t.Run(c, func(t *testing.T) {
+			t.Parallel()
+			for _, s := range inputs {
+				once := fn(s)
+				assert.Equal(t, once, fn(once), "given: '%s'", s)
+			}
+		})
+	}
+}
+
+// TestStandAloneSettles checks that the styles that aren't idempotent stop changing a string after converting it
+// twice
+func TestStandAloneSettles(t *testing.T) {
+	inputs := helperGenIdentifiers()
+	for _, c := range testFunctions {
+		if !notIdempotent[c] {
+			continue
+		}
+		fn := fnMap[c]
+		t.Run(c, func(t *testing.T) {
+			t.Parallel()
+			for _, s := range inputs {
+				twice := fn(fn(s))
+				assert.Equal(t, twice, fn(twice), "given: '%s'", s)
+			}
+		})
+	}
+}
+
+// TestStandAloneNotIdempotent shows the ways the styles that aren't idempotent change a string they've already
+// converted: uppercase runs followed by a digit are split before their last letter, and camel and pascal case run
+// single letters and initialisms together
+func TestStandAloneNotIdempotent(t *testing.T) {
+	assert.Equal(t, "VOL2", ScreamingSnakeCase("vol2"))
+	assert.Equal(t, "VO_L2", ScreamingSnakeCase("VOL2"))
+	assert.Equal(t, "UTF8", Words("utf8"))
+	assert.Equal(t, "UT F8", Words("UTF8"))
+	assert.Equal(t, "aBC", CamelCase("a_b_c"))
+	assert.Equal(t, "aBc", CamelCase(CamelCase("a_b_c")))
+	assert.Equal(t, "utf8_decoder", SnakeCase(SnakeCase("utf8Decoder")))
+}
+
+// TestStandAloneIdempotenceAmbiguous checks idempotence for strings where adjacent tokens can be both entirely
+// uppercase once converted, for the styles that keep them apart (not CamelCase, PascalCase or SCREAMING_SNAKE_CASE,
+// but including their lossless variants)
+func TestStandAloneIdempotenceAmbiguous(t *testing.T) {
+	inputs := helperGenAmbiguous(3)
+	fns := map[string]func(string) string{
+		"LosslessCamelCase":  LosslessCamelCase,
+		"LosslessPascalCase": LosslessPascalCase,
+	}
+	for _, c := range testFunctions {
+		if c != testCamelCase && c != testPascalCase && c != testScreamingSnakeCase {
+			fns[c] = fnMap[c]
+		}
+	}
+
+	for name, st := range fns {
+		fn := st
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			for _, s := range inputs {
+				once := fn(s)
+				assert.Equal(t, once, fn(once), "given: '%s'", s)
+			}
+		})
+	}
+}
+
+// TestStandAloneRoundTrip checks that converting to a lossless style and back keeps the same tokens
+func TestStandAloneRoundTrip(t *testing.T) {
+	inputs := helperGenAmbiguous(3)
+	lossless := map[string]Combiner{
+		testCamelCase:  LosslessCamelCase,
+		testPascalCase: LosslessPascalCase,
+	}
+	for _, st := range testFunctions {
+		c := st
+		from := Combiner(fnMap[c])
+		if l, ok := lossless[c]; ok {
+			from = l
+		}
+		t.Run(c, func(t *testing.T) {
+			t.Parallel()
+			for _, s := range inputs {
+				if c == testScreamingSnakeCase {
+					// SCREAMING_SNAKE_CASE isn't idempotent for these, so start from its settled form
+					s = from(s)
+				}
+				for _, to := range []Combiner{LosslessCamelCase, LosslessPascalCase, SnakeCase, KebabCase} {
+					rt := CheckRoundTrip(s, from, to)
+					if c == testWordCase {
+						// Words keeps the case of the input, which the other styles don't
+						for _, l := range rt.Losses {
+							assert.Equal(t, LostCase, l.Kind, "given: '%s' lost %s", s, l)
+						}
+						continue
+					}
+					assert.True(t, rt.Lossless(), "given: '%s' lost %s", s, rt.Losses)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// Package structflag registers command line flags for the fields of a struct, with each flag's name derived from the
+// field's path through the struct, eg DB.MaxConns -> -db-max-conns
+package structflag
+
+import (
+	"encoding"
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/mantidtech/wordcase"
+	"github.com/mantidtech/wordcase/internal/fields"
+)
+
+// Options control how flag names and usage text are derived
+type Options struct {
+	Prefix    string            // added to the start of every name, eg "server"
+	Separator string            // placed between the prefix and each level of nesting; default "-"
+	Style     wordcase.Combiner // converts each field name; default wordcase.KebabCase
+	Usage     wordcase.Combiner // converts the field path into usage text, when there's no usage tag; default wordcase.SentenceCase
+}
+
+// Flag describes the flag registered for a field
+type Flag struct {
+	Name  string // the flag name, eg "db-max-conns"
+	Path  string // the path to the field, eg "DB.MaxConns"
+	Usage string // the usage text, eg "Db max conns"
+}
+
+// marshalType is the type of encoding.TextMarshaler
+var marshalType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// Register defines a flag on fs for each field of the struct pointed to by dst, with the field's current value as the
+// flag's default.
+//
+//	Field names are converted with the style, and joined to their parents (and the prefix) with the separator.
+//	A field's `flag` tag replaces its converted name, or excludes it when "-", and a `usage` tag gives its usage text.
+//	Embedded structs add no level of nesting, and nil pointers to structs are allocated, except to a struct type that's
+//	already being walked (eg the Next field of a linked list node), which is skipped.
+//	Fields can be strings, bools, ints, int64s, uints, uint64s, float64s, time.Durations, []strings (given as comma
+//	separated values, or by repeating the flag), or implement both encoding.TextMarshaler and encoding.TextUnmarshaler
+func Register(fs *flag.FlagSet, dst any, opts Options) ([]Flag, error) {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a pointer to a struct, got %T", dst)
+	}
+	r := registrar{fs: fs, opts: opts.withDefaults(), guard: fields.NewGuard(v.Elem().Type())}
+	if err := r.walk(v.Elem(), r.opts.Prefix, nil, nil); err != nil {
+		return nil, err
+	}
+	return r.flags, nil
+}
+
+// withDefaults returns the options with defaults in place of any unset
+func (o Options) withDefaults() Options {
+	if o.Separator == "" {
+		o.Separator = "-"
+	}
+	if o.Style == nil {
+		o.Style = wordcase.KebabCase
+	}
+	if o.Usage == nil {
+		o.Usage = wordcase.SentenceCase
+	}
+	return o
+}
+
+// registrar defines the flags for the fields of a struct
+type registrar struct {
+	fs    *flag.FlagSet
+	opts  Options
+	flags []Flag
+	guard fields.Guard
+}
+
+// walk defines the flags for the fields of the struct v, with names starting with name, and usage text from words
+func (r *registrar) walk(v reflect.Value, name string, path, words []string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if fields.Skip(f) {
+			continue
+		}
+		tag := f.Tag.Get("flag")
+		if tag == "-" {
+			continue
+		}
+
+		fv := v.Field(i)
+		fieldPath := append(path[:len(path):len(path)], f.Name)
+		fieldWords := append(words[:len(words):len(words)], f.Name)
+		if fields.IsStruct(f.Type) {
+			if !r.guard.Enter(f.Type) {
+				continue // a recursive type, eg a linked list node
+			}
+			if f.Type.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					fv.Set(reflect.New(f.Type.Elem()))
+				}
+				fv = fv.Elem()
+			}
+			nested := fields.Join(name, r.opts.Separator, r.fieldName(f, tag))
+			if f.Anonymous && tag == "" {
+				nested, fieldWords = name, words
+			}
+			if err := r.walk(fv, nested, fieldPath, fieldWords); err != nil {
+				return err
+			}
+			r.guard.Leave(f.Type)
+			continue
+		}
+
+		fl := Flag{
+			Name:  fields.Join(name, r.opts.Separator, r.fieldName(f, tag)),
+			Path:  strings.Join(fieldPath, "."),
+			Usage: f.Tag.Get("usage"),
+		}
+		if fl.Usage == "" {
+			fl.Usage = r.opts.Usage(strings.Join(fieldWords, " "))
+		}
+		if err := r.define(fv, fl); err != nil {
+			return fmt.Errorf("field %s: %w", fl.Path, err)
+		}
+		r.flags = append(r.flags, fl)
+	}
+	return nil
+}
+
+// fieldName returns the name of a field's level of nesting
+func (r *registrar) fieldName(f reflect.StructField, tag string) string {
+	if tag != "" {
+		return tag
+	}
+	return r.opts.Style(f.Name)
+}
+
+// define defines the flag for the field v
+func (r *registrar) define(v reflect.Value, fl Flag) error {
+	if r.fs.Lookup(fl.Name) != nil {
+		return fmt.Errorf("flag -%s is already defined", fl.Name)
+	}
+	p := v.Addr().Interface()
+	if v.Type() != fields.DurationType && reflect.PointerTo(v.Type()).Implements(fields.UnmarshalerType) && v.Type().Implements(marshalType) {
+		r.fs.TextVar(p.(encoding.TextUnmarshaler), fl.Name, v.Interface().(encoding.TextMarshaler), fl.Usage)
+		return nil
+	}
+
+	switch p := p.(type) {
+	case *string:
+		r.fs.StringVar(p, fl.Name, *p, fl.Usage)
+	case *bool:
+		r.fs.BoolVar(p, fl.Name, *p, fl.Usage)
+	case *int:
+		r.fs.IntVar(p, fl.Name, *p, fl.Usage)
+	case *int64:
+		r.fs.Int64Var(p, fl.Name, *p, fl.Usage)
+	case *uint:
+		r.fs.UintVar(p, fl.Name, *p, fl.Usage)
+	case *uint64:
+		r.fs.Uint64Var(p, fl.Name, *p, fl.Usage)
+	case *float64:
+		r.fs.Float64Var(p, fl.Name, *p, fl.Usage)
+	case *time.Duration:
+		r.fs.DurationVar(p, fl.Name, *p, fl.Usage)
+	case *[]string:
+		r.fs.Var(&stringsValue{p: p}, fl.Name, fl.Usage)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// stringsValue is a flag.Value for a []string, set from comma separated values or by repeating the flag.
+// The first use replaces the default
+type stringsValue struct {
+	p   *[]string
+	set bool
+}
+
+// String implements flag.Value
+func (s *stringsValue) String() string {
+	if s.p == nil {
+		return ""
+	}
+	return strings.Join(*s.p, ",")
+}
+
+// Set implements flag.Value
+func (s *stringsValue) Set(v string) error {
+	if !s.set {
+		*s.p = nil
+		s.set = true
+	}
+	for _, part := range strings.Split(v, ",") {
+		*s.p = append(*s.p, strings.TrimSpace(part))
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package structflag
+
+import (
+	"bytes"
+	"flag"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mantidtech/wordcase"
+)
+
+type testDB struct {
+	MaxConns int
+	Timeout  time.Duration
+}
+
+type testCommon struct {
+	Verbose bool
+}
+
+type testConfig struct {
+	testCommon
+	MaxRetries int
+	Name       string
+	DB         testDB
+	Replica    *testDB `flag:"ro"`
+	Tags       []string
+	Bytes      int64
+	Count      uint
+	Total      uint64
+	Ratio      float64
+	Bind       net.IP
+	Token      string `flag:"api-token" usage:"the token for the API"`
+	Ignored    string `flag:"-"`
+	hidden     string
+}
+
+// TestRegister provides unit test coverage for Register()
+func TestRegister(t *testing.T) {
+	cfg := testConfig{
+		MaxRetries: 3,
+		Tags:       []string{"a"},
+		Bind:       net.ParseIP("127.0.0.1"),
+	}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags, err := Register(fs, &cfg, Options{})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	var names, usages []string
+	for _, f := range flags {
+		names = append(names, f.Name)
+		usages = append(usages, f.Usage)
+	}
+	assert.Equal(t, []string{
+		"verbose", "max-retries", "name", "db-max-conns", "db-timeout", "ro-max-conns", "ro-timeout",
+		"tags", "bytes", "count", "total", "ratio", "bind", "api-token",
+	}, names)
+	assert.Equal(t, []string{
+		"Verbose", "Max retries", "Name", "Db max conns", "Db timeout", "Replica max conns", "Replica timeout",
+		"Tags", "Bytes", "Count", "Total", "Ratio", "Bind", "the token for the API",
+	}, usages)
+	assert.Equal(t, "DB.MaxConns", flags[3].Path)
+	assert.Equal(t, "3", fs.Lookup("max-retries").DefValue)
+
+	err = fs.Parse([]string{
+		"-verbose", "-max-retries=5", "-name", "svc", "-db-max-conns", "10", "-db-timeout", "2s", "-ro-max-conns", "2",
+		"-tags", "x, y", "-tags", "z", "-bytes", "-1", "-count", "4", "-total", "8", "-ratio", "0.5",
+		"-bind", "10.0.0.1", "-api-token", "secret",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, testConfig{
+		testCommon: testCommon{Verbose: true},
+		MaxRetries: 5,
+		Name:       "svc",
+		DB:         testDB{MaxConns: 10, Timeout: 2 * time.Second},
+		Replica:    &testDB{MaxConns: 2},
+		Tags:       []string{"x", "y", "z"},
+		Bytes:      -1,
+		Count:      4,
+		Total:      8,
+		Ratio:      0.5,
+		Bind:       net.ParseIP("10.0.0.1"),
+		Token:      "secret",
+	}, cfg)
+}
+
+// TestRegisterOptions provides unit test coverage for the Options of Register()
+func TestRegisterOptions(t *testing.T) {
+	var cfg testDB
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags, err := Register(fs, &cfg, Options{
+		Prefix:    "db",
+		Separator: ".",
+		Style:     wordcase.SnakeCase,
+		Usage:     wordcase.Words,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []Flag{
+		{Name: "db.max_conns", Path: "MaxConns", Usage: "Max Conns"},
+		{Name: "db.timeout", Path: "Timeout", Usage: "Timeout"},
+	}, flags)
+
+	var b bytes.Buffer
+	fs.SetOutput(&b)
+	fs.PrintDefaults()
+	assert.Contains(t, b.String(), "-db.max_conns int\n    \tMax Conns")
+}
+
+// TestRegister_recursive checks fields of a struct type that's already being walked are skipped, rather than walked
+// forever
+func TestRegister_recursive(t *testing.T) {
+	type node struct {
+		Name  string
+		Next  *node
+		Child struct {
+			Name   string
+			Parent *node
+		}
+	}
+
+	var cfg node
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags, err := Register(fs, &cfg, Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, []Flag{
+		{Name: "name", Path: "Name", Usage: "Name"},
+		{Name: "child-name", Path: "Child.Name", Usage: "Child name"},
+	}, flags)
+	assert.Nil(t, cfg.Next)
+	assert.Nil(t, cfg.Child.Parent)
+
+	assert.NoError(t, fs.Parse([]string{"-name", "root", "-child-name", "leaf"}))
+	assert.Equal(t, "root", cfg.Name)
+	assert.Equal(t, "leaf", cfg.Child.Name)
+}
+
+// TestRegisterErrors provides unit test coverage for the errors from Register()
+func TestRegisterErrors(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+
+	_, err := Register(fs, testConfig{}, Options{})
+	assert.EqualError(t, err, "expected a pointer to a struct, got structflag.testConfig")
+
+	_, err = Register(fs, &struct{ Ch chan int }{}, Options{})
+	assert.EqualError(t, err, "field Ch: unsupported type chan int")
+
+	_, err = Register(fs, &struct{ A, B string }{}, Options{Style: func(string) string { return "same" }})
+	assert.EqualError(t, err, "field B: flag -same is already defined")
+}
@@ -0,0 +1,142 @@
+package wordcase
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Styles are the conversions provided by this package, by name, for choosing a style at run time (eg from configuration)
+var Styles = map[string]Combiner{
+	"camel":           CamelCase,
+	"dot":             DotCase,
+	"go-exported":     GoExported,
+	"go-unexported":   GoUnexported,
+	"header":          HeaderCase,
+	"kebab":           KebabCase,
+	"lossless-camel":  LosslessCamelCase,
+	"lossless-pascal": LosslessPascalCase,
+	"pascal":          PascalCase,
+	"screaming-snake": ScreamingSnakeCase,
+	"sentence":        SentenceCase,
+	"slug":            Slug,
+	"snake":           SnakeCase,
+	"title":           TitleCase,
+	"train":           TrainCase,
+	"words":           Words,
+
+	"plural-safe-camel":           PluralSafeCamelCase,
+	"plural-safe-dot":             PluralSafeDotCase,
+	"plural-safe-go-exported":     PluralSafeGoExported,
+	"plural-safe-go-unexported":   PluralSafeGoUnexported,
+	"plural-safe-kebab":           PluralSafeKebabCase,
+	"plural-safe-pascal":          PluralSafePascalCase,
+	"plural-safe-screaming-snake": PluralSafeScreamingSnakeCase,
+	"plural-safe-snake":           PluralSafeSnakeCase,
+	"plural-safe-title":           PluralSafeTitleCase,
+	"plural-safe-words":           PluralSafeWords,
+}
+
+// StyleByName returns the style with the given name from Styles.
+//
+//	The name can be written in any style, with or without a "case" suffix,
+//	eg "screaming-snake", "ScreamingSnake", "SCREAMING_SNAKE_CASE" all find ScreamingSnakeCase
+func StyleByName(name string) (Combiner, bool) {
+	key := strings.TrimSuffix(KebabCase(name), "-case")
+	c, ok := Styles[key]
+	return c, ok
+}
+
+// StyleNames returns the names of the styles in Styles, sorted
+func StyleNames() []string {
+	ret := make([]string, 0, len(Styles))
+	for name := range Styles {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// DetectStyle returns the name of the style in Styles the string appears to be written in, from its separators and
+// the case of its letters, or "" if it doesn't follow any of them (eg "User_name", "user/name").
+//
+//	A single word is reported as the most specific style it fits: "snake" when lowercase,
+//	"screaming-snake" when uppercase, and "pascal" when capitalised
+func DetectStyle(s string) string {
+	var upper, lower, sep int
+	var sepRune rune
+	for _, r := range s {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		case unicode.IsDigit(r):
+		case r == '_' || r == '-' || r == '.' || r == ' ':
+			if sep > 0 && r != sepRune {
+				return ""
+			}
+			sep++
+			sepRune = r
+		default:
+			return ""
+		}
+	}
+	if upper+lower == 0 {
+		return ""
+	}
+
+	switch {
+	case sepRune == ' ':
+		return detectSpaced(strings.Fields(s))
+	case upper == 0 && (sep == 0 || sepRune == '_'):
+		return "snake"
+	case upper == 0 && sepRune == '-':
+		return "kebab"
+	case upper == 0 && sepRune == '.':
+		return "dot"
+	case lower == 0 && (sep == 0 || sepRune == '_'):
+		return "screaming-snake"
+	case sepRune == '-' && lower > 0 && capitalised(strings.Split(s, "-")):
+		return "train"
+	case sep > 0:
+		return ""
+	case unicode.IsUpper([]rune(s)[0]):
+		return "pascal"
+	default:
+		return "camel"
+	}
+}
+
+// capitalised returns true if none of the words start with a lowercase letter
+func capitalised(words []string) bool {
+	for _, w := range words {
+		if r, _ := utf8.DecodeRuneInString(w); unicode.IsLower(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// detectSpaced returns the name of the style for space separated words: "title" when every word is capitalised,
+// "sentence" when only the first is (ignoring initialisms), otherwise "words"
+func detectSpaced(words []string) string {
+	title, sentence := true, !unicode.IsLower([]rune(words[0])[0])
+	for i, w := range words {
+		if unicode.IsLower([]rune(w)[0]) {
+			title = false
+		}
+		if i > 0 && strings.ToUpper(w) != w && strings.ToLower(w) != w {
+			sentence = false
+		}
+	}
+	switch {
+	case title:
+		return "title"
+	case sentence:
+		return "sentence"
+	default:
+		return "words"
+	}
+}
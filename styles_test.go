@@ -0,0 +1,95 @@
+package wordcase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestStyleByName provides unit test coverage for StyleByName()
+func TestStyleByName(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   string
+		wantOK bool
+	}{
+		{name: "snake", want: "one_two", wantOK: true},
+		{name: "snake_case", want: "one_two", wantOK: true},
+		{name: "ScreamingSnake", want: "ONE_TWO", wantOK: true},
+		{name: "SCREAMING_SNAKE_CASE", want: "ONE_TWO", wantOK: true},
+		{name: "kebab-case", want: "one-two", wantOK: true},
+		{name: "camelCase", want: "oneTwo", wantOK: true},
+		{name: "go exported", want: "OneTwo", wantOK: true},
+		{name: "nope", wantOK: false},
+		{name: "", wantOK: false},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := StyleByName(tt.name)
+			assert.Equal(t, tt.wantOK, ok)
+			if ok {
+				assert.Equal(t, tt.want, got("one two"))
+			}
+		})
+	}
+}
+
+// TestStyleNames provides unit test coverage for StyleNames()
+func TestStyleNames(t *testing.T) {
+	got := StyleNames()
+	assert.Len(t, got, len(Styles))
+	assert.IsIncreasing(t, got)
+	for _, name := range got {
+		_, ok := StyleByName(name)
+		assert.True(t, ok, name)
+	}
+}
+
+// TestDetectStyle provides unit test coverage for DetectStyle()
+func TestDetectStyle(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "user_name", want: "snake"},
+		{s: "user", want: "snake"},
+		{s: "USER_NAME", want: "screaming-snake"},
+		{s: "USER", want: "screaming-snake"},
+		{s: "user-name", want: "kebab"},
+		{s: "user.name", want: "dot"},
+		{s: "userName", want: "camel"},
+		{s: "UserName", want: "pascal"},
+		{s: "User", want: "pascal"},
+		{s: "User Name", want: "title"},
+		{s: "user Name", want: "words"},
+		{s: "User name", want: "sentence"},
+		{s: "User name ID", want: "sentence"},
+		{s: "User name Id", want: "words"},
+		{s: "user2_name", want: "snake"},
+		{s: "User_Name", want: ""},
+		{s: "user-name_two", want: ""},
+		{s: "USER-NAME", want: ""},
+		{s: "User-Name", want: "train"},
+		{s: "X-Request-ID", want: "train"},
+		{s: "User-name", want: ""},
+		{s: "user/name", want: ""},
+		{s: "_", want: ""},
+		{s: "", want: ""},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.s, func(t *testing.T) {
+			t.Parallel()
+			got := DetectStyle(tt.s)
+			assert.Equal(t, tt.want, got)
+			if got != "" {
+				_, ok := Styles[got]
+				assert.True(t, ok)
+			}
+		})
+	}
+}
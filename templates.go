@@ -0,0 +1,99 @@
+package wordcase
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// FuncMap returns template functions for every style in Styles, named in camel case (eg "snake", "screamingSnake",
+// "pluralSafeKebab"), along with:
+//
+//	case      converts with the style named by the first argument, eg {{ case "kebab" .Name }}
+//	detect    returns the name of the style a string is written in (see DetectStyle)
+//	tokens    breaks a string into a slice of tokens
+//	caseEach  converts each string in a slice with the named style, eg {{ caseEach "snake" .Fields }}
+//	joinCase  converts the strings in a slice as the words of a single name, eg {{ joinCase "camel" .Words }}
+//	join      joins a slice of strings with a separator, eg {{ join ", " (caseEach "snake" .Fields) }}
+//
+// The functions work with both text/template and html/template, eg html/template.FuncMap(wordcase.FuncMap())
+func FuncMap() template.FuncMap {
+	fm := template.FuncMap{
+		"case":     caseByName,
+		"detect":   DetectStyle,
+		"tokens":   func(s string) []string { return Tokenizer(s) },
+		"caseEach": caseEach,
+		"joinCase": joinCase,
+		"join":     join,
+	}
+	for name, c := range Styles {
+		fm[CamelCase(name)] = c
+	}
+	return fm
+}
+
+// caseByName converts the string with the named style
+func caseByName(style, s string) (string, error) {
+	c, ok := StyleByName(style)
+	if !ok {
+		return "", fmt.Errorf("unknown style %q", style)
+	}
+	return c(s), nil
+}
+
+// caseEach converts each string in the list with the named style
+func caseEach(style string, list any) ([]string, error) {
+	c, ok := StyleByName(style)
+	if !ok {
+		return nil, fmt.Errorf("unknown style %q", style)
+	}
+	ss, err := toStrings(list)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]string, len(ss))
+	for i, s := range ss {
+		ret[i] = c(s)
+	}
+	return ret, nil
+}
+
+// joinCase converts the strings in the list, as the words of a single name, with the named style
+func joinCase(style string, list any) (string, error) {
+	ss, err := toStrings(list)
+	if err != nil {
+		return "", err
+	}
+	return caseByName(style, strings.Join(ss, " "))
+}
+
+// join joins the strings in the list with the separator
+func join(sep string, list any) (string, error) {
+	ss, err := toStrings(list)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(ss, sep), nil
+}
+
+// toStrings converts a list passed to a template function to a slice of strings
+func toStrings(list any) ([]string, error) {
+	switch l := list.(type) {
+	case []string:
+		return l, nil
+	case Tokens:
+		return l, nil
+	case []any:
+		ret := make([]string, len(l))
+		for i, v := range l {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected a list of strings, item %d is %T", i, v)
+			}
+			ret[i] = s
+		}
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("expected a list of strings, got %T", list)
+	}
+}
@@ -0,0 +1,83 @@
+package wordcase
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFuncMap provides unit test coverage for FuncMap()
+func TestFuncMap(t *testing.T) {
+	data := map[string]any{
+		"Name":   "user id",
+		"Fields": []string{"FirstName", "last-name"},
+		"Any":    []any{"UserIDs"},
+		"Bad":    []any{1},
+		"Int":    3,
+	}
+
+	tests := []struct {
+		name    string
+		tmpl    string
+		want    string
+		wantErr bool
+	}{
+		{name: "style", tmpl: `{{ snake .Name }}`, want: "user_id"},
+		{name: "multi word style", tmpl: `{{ screamingSnake .Name }}`, want: "USER_ID"},
+		{name: "go style", tmpl: `{{ goExported .Name }}`, want: "UserID"},
+		{name: "plural safe", tmpl: `{{ pluralSafeKebab "UserIDs" }}`, want: "user-ids"},
+		{name: "pipeline", tmpl: `{{ .Name | pascal }}`, want: "UserID"},
+		{name: "dynamic", tmpl: `{{ case "kebab" .Name }}`, want: "user-id"},
+		{name: "dynamic any name", tmpl: `{{ case "SCREAMING_SNAKE_CASE" .Name }}`, want: "USER_ID"},
+		{name: "dynamic unknown", tmpl: `{{ case "sponge" .Name }}`, wantErr: true},
+		{name: "detect", tmpl: `{{ detect "userName" }}`, want: "camel"},
+		{name: "tokens", tmpl: `{{ range tokens "HTTPServer" }}[{{ . }}]{{ end }}`, want: "[HTTP][Server]"},
+		{name: "case each", tmpl: `{{ join ", " (caseEach "snake" .Fields) }}`, want: "first_name, last_name"},
+		{name: "case each pipeline", tmpl: `{{ .Fields | caseEach "camel" | join "," }}`, want: "firstName,lastName"},
+		{name: "case each any", tmpl: `{{ join "" (caseEach "pluralSafeSnake" .Any) }}`, want: "user_ids"},
+		{name: "case each unknown", tmpl: `{{ caseEach "sponge" .Fields }}`, wantErr: true},
+		{name: "case each not strings", tmpl: `{{ caseEach "snake" .Bad }}`, wantErr: true},
+		{name: "case each not a list", tmpl: `{{ caseEach "snake" .Int }}`, wantErr: true},
+		{name: "join case", tmpl: `{{ joinCase "pascal" .Fields }}`, want: "FirstNameLastName"},
+		{name: "join case not a list", tmpl: `{{ joinCase "pascal" .Int }}`, wantErr: true},
+		{name: "join not a list", tmpl: `{{ join "," .Int }}`, wantErr: true},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tmpl, err := template.New(tt.name).Funcs(FuncMap()).Parse(tt.tmpl)
+			if !assert.NoError(t, err) {
+				t.FailNow()
+			}
+			var b strings.Builder
+			err = tmpl.Execute(&b, data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, b.String())
+		})
+	}
+}
+
+// TestFuncMapStyles checks FuncMap has a function for every style
+func TestFuncMapStyles(t *testing.T) {
+	fm := FuncMap()
+	for name := range Styles {
+		assert.Contains(t, fm, CamelCase(name), name)
+	}
+}
+
+// TestFuncMapHTML checks FuncMap can be used with html/template
+func TestFuncMapHTML(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("html").Funcs(htmltemplate.FuncMap(FuncMap())).Parse(`<p id="{{ kebab . }}">{{ title . }}</p>`))
+	var b strings.Builder
+	assert.NoError(t, tmpl.Execute(&b, "user <name>"))
+	assert.Equal(t, `<p id="user-name">User Name</p>`, b.String())
+}
@@ -1,7 +1,10 @@
 package wordcase
 
 import (
+	"regexp"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Tokens represents a string broken up by boundaries defined by IsRuneSeparator functions
@@ -12,15 +15,14 @@ func (t Tokens) String() string {
 	return strings.Join(t, " ")
 }
 
-// Format applies to the given function to the tokens specified by the 'indexes' list
+// Format applies to the given function to the tokens specified by the 'indexes' list.
+//
+//	The selector's output is normalised into an IndexSet, so out of range and duplicate indices are ignored
 func (t Tokens) Format(fn Formatter, items TokenSelector) Tokens {
 	var r Tokens
-	idx := map[int]bool{}
-	for _, i := range items(t) {
-		idx[i] = true
-	}
+	idx := t.Select(items)
 	for i, x := range t {
-		if idx[i] {
+		if idx.Has(i) {
 			r = append(r, fn(x))
 		} else {
 			r = append(r, x)
@@ -70,3 +72,121 @@ func TokenizeString(s string, test SeparatorTest, sepRune IsRuneSeparator, rmSep
 	}
 	return res
 }
+
+// TokenizeRegexp applies TokenizeStringRegexp to each token, returning a new token set
+func (t Tokens) TokenizeRegexp(re *regexp.Regexp, keepUnmatched bool) Tokens {
+	var r Tokens
+	for _, x := range t {
+		r = append(r, TokenizeStringRegexp(x, re, keepUnmatched)...)
+	}
+	return r
+}
+
+// SplitRegexp applies SplitStringRegexp to each token, returning a new token set
+func (t Tokens) SplitRegexp(re *regexp.Regexp, rmSep bool) Tokens {
+	var r Tokens
+	for _, x := range t {
+		r = append(r, SplitStringRegexp(x, re, rmSep)...)
+	}
+	return r
+}
+
+// TokenizeStringRegexp breaks a string into the tokens matched by the given expression.
+//
+//	If the expression has capturing groups, each non-empty group in a match is a token and any text matched outside
+//	of a group is discarded, otherwise each non-empty match is a token.
+//	Text between matches is kept as a token of its own if keepUnmatched is true, or discarded if not.
+//	Go's regexp package doesn't support lookaround, so groups are the way to split a single match into several tokens,
+//	eg `([A-Z]*)([A-Z][a-z]+)|([A-Z]+)|(\d+)` splits "XMLHttp2" into "XML", "Http", "2"
+func TokenizeStringRegexp(s string, re *regexp.Regexp, keepUnmatched bool) Tokens {
+	res := Tokens{}
+	groups := re.NumSubexp() > 0
+	at := 0
+	for _, m := range re.FindAllStringSubmatchIndex(s, -1) {
+		if keepUnmatched && m[0] > at {
+			res = append(res, s[at:m[0]])
+		}
+		at = m[1]
+		if !groups {
+			if m[1] > m[0] {
+				res = append(res, s[m[0]:m[1]])
+			}
+			continue
+		}
+		for g := 2; g < len(m); g += 2 {
+			if m[g] >= 0 && m[g+1] > m[g] {
+				res = append(res, s[m[g]:m[g+1]])
+			}
+		}
+	}
+	if keepUnmatched && at < len(s) {
+		res = append(res, s[at:])
+	}
+	return res
+}
+
+// SplitStringRegexp breaks a string into tokens separated by text matching the given expression.
+//
+//	set rmSep to true to delete the separating text, or false to make it part of the next token.
+//	Empty tokens are never returned
+func SplitStringRegexp(s string, re *regexp.Regexp, rmSep bool) Tokens {
+	res := Tokens{}
+	at := 0
+	for _, m := range re.FindAllStringIndex(s, -1) {
+		if m[0] > at {
+			res = append(res, s[at:m[0]])
+		}
+		at = m[1]
+		if !rmSep {
+			at = m[0]
+		}
+	}
+	if at < len(s) {
+		res = append(res, s[at:])
+	}
+	return res
+}
+
+// Drop removes the tokens specified by the selector
+func (t Tokens) Drop(items TokenSelector) Tokens {
+	r := Tokens{}
+	idx := t.Select(items)
+	for i, x := range t {
+		if !idx.Has(i) {
+			r = append(r, x)
+		}
+	}
+	return r
+}
+
+// KeepPlurals rejoins plural initialisms that were split at their last capital, eg "URLs" tokenized as "UR", "Ls".
+// A token of a capital followed by "s" is joined to a preceding token that's all capitals,
+// so "UserIDs" becomes "User", "IDs" rather than "User", "I", "Ds".
+// The tokens should all come from the same word, as there's no telling if separators were removed between them
+func (t Tokens) KeepPlurals() Tokens {
+	r := Tokens{}
+	for _, x := range t {
+		if len(r) > 0 && isPluralTail(x) && isAllUpper(r[len(r)-1]) {
+			r[len(r)-1] += x
+			continue
+		}
+		r = append(r, x)
+	}
+	return r
+}
+
+// isPluralTail returns true if the token is a capital letter followed by "s", eg "Ds" split from "IDs"
+func isPluralTail(s string) bool {
+	r, n := utf8.DecodeRuneInString(s)
+	return unicode.IsUpper(r) && s[n:] == "s"
+}
+
+// isAllUpper returns true if the token is made of capital letters only
+func isAllUpper(s string) bool {
+	for _, r := range s {
+		if !unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return s != ""
+}
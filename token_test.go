@@ -1,6 +1,8 @@
 package wordcase
 
 import (
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -38,6 +40,126 @@ func TestTokens_String(t *testing.T) {
 	}
 }
 
+func TestTokens_Format(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Tokens
+		sel  TokenSelector
+		want Tokens
+	}{
+		{
+			name: "empty",
+			t:    Tokens{},
+			sel:  ToLast,
+			want: nil,
+		},
+		{
+			name: "selected",
+			t:    Tokens{"one", "two", "three"},
+			sel:  ToRest,
+			want: Tokens{"one", "TWO", "THREE"},
+		},
+		{
+			name: "unsorted duplicates and out of range",
+			t:    Tokens{"one", "two", "three"},
+			sel:  func(Tokens) []int { return []int{2, -1, 2, 0, 3} },
+			want: Tokens{"ONE", "two", "THREE"},
+		},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.t.Format(strings.ToUpper, tt.sel)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTokens_Drop(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Tokens
+		sel  TokenSelector
+		want Tokens
+	}{
+		{
+			name: "empty",
+			t:    Tokens{},
+			sel:  ToFirst,
+			want: Tokens{},
+		},
+		{
+			name: "some",
+			t:    Tokens{"one", "two", "three"},
+			sel:  ToRest,
+			want: Tokens{"one"},
+		},
+		{
+			name: "all",
+			t:    Tokens{"one", "two"},
+			sel:  ToAll,
+			want: Tokens{},
+		},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.t.Drop(tt.sel)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestTokens_KeepPlurals provides unit test coverage for Tokens.KeepPlurals()
+func TestTokens_KeepPlurals(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Tokens
+		want Tokens
+	}{
+		{
+			name: "empty",
+			t:    Tokens{},
+			want: Tokens{},
+		},
+		{
+			name: "plural initialism",
+			t:    Tokens{"User", "I", "Ds"},
+			want: Tokens{"User", "IDs"},
+		},
+		{
+			name: "several",
+			t:    Tokens{"UR", "Ls", "For", "AP", "Is"},
+			want: Tokens{"URLs", "For", "APIs"},
+		},
+		{
+			name: "after a word",
+			t:    Tokens{"This", "Is"},
+			want: Tokens{"This", "Is"},
+		},
+		{
+			name: "at the start",
+			t:    Tokens{"Is", "It"},
+			want: Tokens{"Is", "It"},
+		},
+		{
+			name: "not a plural",
+			t:    Tokens{"HTTP", "Servers"},
+			want: Tokens{"HTTP", "Servers"},
+		},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.t.KeepPlurals()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestTokens_FormatAll(t *testing.T) {
 	type args struct {
 		fn Formatter
@@ -193,3 +315,107 @@ func TestTokenizeString(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenizeStringRegexp(t *testing.T) {
+	type args struct {
+		s             string
+		re            *regexp.Regexp
+		keepUnmatched bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want Tokens
+	}{
+		{
+			name: "empty",
+			args: args{s: "", re: regexp.MustCompile(`[a-z]+`)},
+			want: Tokens{},
+		},
+		{
+			name: "matches",
+			args: args{s: "one, two; three", re: regexp.MustCompile(`[a-z]+`)},
+			want: Tokens{"one", "two", "three"},
+		},
+		{
+			name: "keep unmatched",
+			args: args{s: "$one, two;", re: regexp.MustCompile(`[a-z]+`), keepUnmatched: true},
+			want: Tokens{"$", "one", ", ", "two", ";"},
+		},
+		{
+			name: "empty matches ignored",
+			args: args{s: "a1b", re: regexp.MustCompile(`[a-z]*`)},
+			want: Tokens{"a", "b"},
+		},
+		{
+			name: "groups",
+			args: args{s: "XMLHttp2", re: regexp.MustCompile(`([A-Z]*)([A-Z][a-z]+)|([A-Z]+)|(\d+)`)},
+			want: Tokens{"XML", "Http", "2"},
+		},
+		{
+			name: "text outside groups discarded",
+			args: args{s: "k=v,x=y", re: regexp.MustCompile(`(\w)=(\w)`)},
+			want: Tokens{"k", "v", "x", "y"},
+		},
+		{
+			name: "text outside groups discarded, unmatched kept",
+			args: args{s: "k=v,x=y", re: regexp.MustCompile(`(\w)=(\w)`), keepUnmatched: true},
+			want: Tokens{"k", "v", ",", "x", "y"},
+		},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := TokenizeStringRegexp(tt.args.s, tt.args.re, tt.args.keepUnmatched)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitStringRegexp(t *testing.T) {
+	type args struct {
+		s     string
+		re    *regexp.Regexp
+		rmSep bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want Tokens
+	}{
+		{
+			name: "empty",
+			args: args{s: "", re: regexp.MustCompile(`_+`), rmSep: true},
+			want: Tokens{},
+		},
+		{
+			name: "no separators",
+			args: args{s: "one", re: regexp.MustCompile(`_+`), rmSep: true},
+			want: Tokens{"one"},
+		},
+		{
+			name: "remove separators",
+			args: args{s: "__one__two_", re: regexp.MustCompile(`_+`), rmSep: true},
+			want: Tokens{"one", "two"},
+		},
+		{
+			name: "keep separators",
+			args: args{s: "__one__two_", re: regexp.MustCompile(`_+`), rmSep: false},
+			want: Tokens{"__one", "__two", "_"},
+		},
+		{
+			name: "split before uppercase",
+			args: args{s: "oneTwoThree", re: regexp.MustCompile(`[A-Z]`), rmSep: false},
+			want: Tokens{"one", "Two", "Three"},
+		},
+	}
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := SplitStringRegexp(tt.args.s, tt.args.re, tt.args.rmSep)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
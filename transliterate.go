@@ -0,0 +1,209 @@
+package wordcase
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// TransliterationTable maps runes to their ASCII replacements
+type TransliterationTable map[rune]string
+
+// withUpper adds the uppercase form of each lowercase rune in the table, replaced by its capitalised replacement,
+// eg 'ж' -> "zh" adds 'Ж' -> "Zh"
+func (t TransliterationTable) withUpper() TransliterationTable {
+	ret := make(TransliterationTable, len(t)*2)
+	for r, s := range t {
+		ret[r] = s
+		if u := unicode.ToUpper(r); u != r && u > unicode.MaxASCII {
+			if _, ok := t[u]; !ok {
+				ret[u] = UppercaseFirst(s)
+			}
+		}
+	}
+	return ret
+}
+
+// LatinTable replaces Latin letters with diacritics by their base letter, and ligatures by their letters,
+// eg "é" -> "e", "ü" -> "u", "ß" -> "ss", "æ" -> "ae", "ø" -> "o", "þ" -> "th"
+var LatinTable = TransliterationTable{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae",
+	'ç': "c", 'ć': "c", 'ĉ': "c", 'ċ': "c", 'č': "c",
+	'ď': "d", 'đ': "d", 'ð': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ĕ': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ĝ': "g", 'ğ': "g", 'ġ': "g", 'ģ': "g",
+	'ĥ': "h", 'ħ': "h",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ĩ': "i", 'ī': "i", 'ĭ': "i", 'į': "i", 'ı': "i",
+	'ĳ': "ij",
+	'ĵ': "j",
+	'ķ': "k", 'ĸ': "k",
+	'ĺ': "l", 'ļ': "l", 'ľ': "l", 'ŀ': "l", 'ł': "l",
+	'ñ': "n", 'ń': "n", 'ņ': "n", 'ň': "n", 'ŉ': "n",
+	'ŋ': "ng",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'ŏ': "o", 'ő': "o",
+	'œ': "oe",
+	'ŕ': "r", 'ŗ': "r", 'ř': "r",
+	'ś': "s", 'ŝ': "s", 'ş': "s", 'š': "s", 'ș': "s", 'ſ': "s",
+	'ß': "ss", 'ẞ': "SS",
+	'ţ': "t", 'ť': "t", 'ŧ': "t", 'ț': "t",
+	'þ': "th",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ũ': "u", 'ū': "u", 'ŭ': "u", 'ů': "u", 'ű': "u", 'ų': "u",
+	'ŵ': "w",
+	'ý': "y", 'ÿ': "y", 'ŷ': "y",
+	'ź': "z", 'ż': "z", 'ž': "z",
+}.withUpper()
+
+// CyrillicTable romanises Cyrillic letters, following Russian usage for the letters shared with Ukrainian and
+// Belarusian, eg "ж" -> "zh", "щ" -> "shch"
+var CyrillicTable = TransliterationTable{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya",
+	'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u",
+}.withUpper()
+
+// GreekTable romanises Greek letters, eg "θ" -> "th", "ψ" -> "ps"
+var GreekTable = TransliterationTable{
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k",
+	'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t",
+	'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	'ά': "a", 'έ': "e", 'ή': "i", 'ί': "i", 'ό': "o", 'ύ': "y", 'ώ': "o", 'ϊ': "i", 'ϋ': "y", 'ΐ': "i", 'ΰ': "y",
+}.withUpper()
+
+// GermanTable replaces umlauts following German usage, eg "ü" -> "ue", "ß" -> "ss"; use it ahead of LatinTable
+var GermanTable = TransliterationTable{
+	'ä': "ae", 'ö': "oe", 'ü': "ue", 'ß': "ss",
+}.withUpper()
+
+// NordicTable replaces letters following Danish and Norwegian usage, eg "å" -> "aa", "ø" -> "oe";
+// use it ahead of LatinTable
+var NordicTable = TransliterationTable{
+	'å': "aa", 'æ': "ae", 'ø': "oe",
+}.withUpper()
+
+// PunctuationTable replaces typographic punctuation and spaces with their ASCII equivalents, eg "’" -> "'", "—" -> "-"
+var PunctuationTable = TransliterationTable{
+	'‘': "'", '’': "'", '‚': "'", '‛': "'", '′': "'",
+	'“': `"`, '”': `"`, '„': `"`, '«': `"`, '»': `"`, '″': `"`,
+	'‐': "-", '‑': "-", '‒': "-", '–': "-", '—': "-", '―': "-", '−': "-",
+	'…': "...", '•': "*", '·': ".",
+	'\u00a0': " ", '\u2002': " ", '\u2003': " ", '\u2009': " ", '\u202f': " ",
+}
+
+// DefaultTransliterationTables are the tables used by Transliterate, in order of precedence
+var DefaultTransliterationTables = []TransliterationTable{LatinTable, CyrillicTable, GreekTable, PunctuationTable}
+
+// TransliterationMode decides what happens to non-ASCII runes that aren't in any table
+type TransliterationMode int
+
+// The ways of handling non-ASCII runes that aren't in any table
+const (
+	KeepUnmappable    TransliterationMode = iota // leave them unchanged (best effort)
+	DropUnmappable                               // remove them, so the result is always ASCII
+	ErrorOnUnmappable                            // report an *UnmappableError
+)
+
+// String returns the name of the mode
+func (m TransliterationMode) String() string {
+	switch m {
+	case KeepUnmappable:
+		return "keep unmappable"
+	case DropUnmappable:
+		return "drop unmappable"
+	case ErrorOnUnmappable:
+		return "error on unmappable"
+	default:
+		return "unknown mode"
+	}
+}
+
+// UnmappableError reports a non-ASCII rune that isn't in any of the tables
+type UnmappableError struct {
+	Rune   rune
+	Offset int // the byte offset of the rune in the string
+}
+
+// Error implements error
+func (e *UnmappableError) Error() string {
+	return fmt.Sprintf("no ASCII transliteration for %q (%U) at offset %d", e.Rune, e.Rune, e.Offset)
+}
+
+// Transliterator replaces non-ASCII runes using tables
+type Transliterator struct {
+	Tables []TransliterationTable // the tables to look runes up in, in order of precedence
+	Mode   TransliterationMode    // what to do with non-ASCII runes that aren't in any table
+}
+
+// DefaultTransliterator uses the DefaultTransliterationTables, keeping unmappable runes
+var DefaultTransliterator = Transliterator{Tables: DefaultTransliterationTables}
+
+// ASCIIFold is a Formatter that transliterates using the DefaultTransliterationTables and drops anything else,
+// so the result is always ASCII, eg "Ærø" -> "Aero", "日本 Straße" -> " Strasse"
+var ASCIIFold = Transliterator{Tables: DefaultTransliterationTables, Mode: DropUnmappable}.Formatter()
+
+// Transliterate replaces the runes in the DefaultTransliterationTables with their ASCII replacements,
+// leaving any others unchanged, eg "Crème Brûlée" -> "Creme Brulee", "Москва" -> "Moskva"
+func Transliterate(s string) string {
+	return DefaultTransliterator.Formatter()(s)
+}
+
+// Transliterate replaces the non-ASCII runes in s, returning an *UnmappableError for the first rune that isn't in
+// any table when the mode is ErrorOnUnmappable
+func (t Transliterator) Transliterate(s string) (string, error) {
+	var b strings.Builder
+	for i, r := range s {
+		if r <= unicode.MaxASCII {
+			b.WriteRune(r)
+			continue
+		}
+		if rep, ok := lookupTables(t.Tables, r); ok {
+			b.WriteString(rep)
+			continue
+		}
+		switch t.Mode {
+		case KeepUnmappable:
+			b.WriteRune(r)
+		case ErrorOnUnmappable:
+			return "", &UnmappableError{Rune: r, Offset: i}
+		}
+	}
+	return b.String(), nil
+}
+
+// Formatter returns the transliterator as a Formatter, for use in a pipeline.
+//
+//	A Formatter can't report errors, so with ErrorOnUnmappable runes that aren't in any table are dropped;
+//	use Apply, or Pipeline.TransliterateChecked in a pipeline, to have them reported
+func (t Transliterator) Formatter() Formatter {
+	return func(s string) string {
+		out, err := t.Transliterate(s)
+		if err != nil {
+			out, _ = Transliterator{Tables: t.Tables, Mode: DropUnmappable}.Transliterate(s)
+		}
+		return out
+	}
+}
+
+// Apply returns a function that transliterates a string then converts it with the style, reporting an error for any
+// rune that can't be transliterated when the mode is ErrorOnUnmappable
+func (t Transliterator) Apply(style Combiner) func(string) (string, error) {
+	return func(s string) (string, error) {
+		out, err := t.Transliterate(s)
+		if err != nil {
+			return "", err
+		}
+		return style(out), nil
+	}
+}
+
+// lookupTables returns the replacement for the rune from the first table that has one
+func lookupTables(tables []TransliterationTable, r rune) (string, bool) {
+	for _, t := range tables {
+		if rep, ok := t[r]; ok {
+			return rep, true
+		}
+	}
+	return "", false
+}
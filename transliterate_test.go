@@ -0,0 +1,130 @@
+package wordcase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTransliterate provides unit test coverage for Transliterate()
+func TestTransliterate(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "empty", s: "", want: ""},
+		{name: "ascii", s: "plain text", want: "plain text"},
+		{name: "french", s: "Crème Brûlée", want: "Creme Brulee"},
+		{name: "german", s: "über Straße", want: "uber Strasse"},
+		{name: "nordic", s: "Ærø Ångström Þór", want: "Aero Angstrom Thor"},
+		{name: "polish", s: "Łódź", want: "Lodz"},
+		{name: "russian", s: "Москва щи", want: "Moskva shchi"},
+		{name: "ukrainian", s: "Україна", want: "Ukrayina"},
+		{name: "hard sign", s: "объект", want: "obekt"},
+		{name: "greek", s: "Ελλάδα θέατρο", want: "Ellada theatro"},
+		{name: "unmapped", s: "日本 ☃", want: "日本 ☃"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Transliterate(tt.s)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestTransliterationTables checks every replacement in the tables is ASCII
+func TestTransliterationTables(t *testing.T) {
+	for _, table := range append(DefaultTransliterationTables, GermanTable, NordicTable) {
+		for r, s := range table {
+			for _, c := range s {
+				assert.LessOrEqual(t, c, rune(127), "%q -> %q", r, s)
+			}
+		}
+	}
+}
+
+// TestTransliterator_Transliterate provides unit test coverage for Transliterator.Transliterate()
+func TestTransliterator_Transliterate(t *testing.T) {
+	german := []TransliterationTable{GermanTable, LatinTable}
+	tests := []struct {
+		name    string
+		t       Transliterator
+		s       string
+		want    string
+		wantErr error
+	}{
+		{name: "keep", t: DefaultTransliterator, s: "Ærø 日本", want: "Aero 日本"},
+		{name: "drop", t: Transliterator{Tables: DefaultTransliterationTables, Mode: DropUnmappable}, s: "Ærø 日本", want: "Aero "},
+		{name: "error", t: Transliterator{Tables: DefaultTransliterationTables, Mode: ErrorOnUnmappable}, s: "Ærø 日本", wantErr: &UnmappableError{Rune: '日', Offset: 6}},
+		{name: "error mappable", t: Transliterator{Tables: DefaultTransliterationTables, Mode: ErrorOnUnmappable}, s: "Straße", want: "Strasse"},
+		{name: "german table first", t: Transliterator{Tables: german}, s: "Über Größe", want: "Ueber Groesse"},
+		{name: "nordic table first", t: Transliterator{Tables: []TransliterationTable{NordicTable, LatinTable}}, s: "Ærø Ålborg", want: "Aeroe Aalborg"},
+		{name: "punctuation", t: DefaultTransliterator, s: "it’s “quoted” — …", want: `it's "quoted" - ...`},
+		{name: "no tables", t: Transliterator{Mode: DropUnmappable}, s: "café", want: "caf"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.t.Transliterate(tt.s)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestTransliterator_Formatter provides unit test coverage for Transliterator.Formatter()
+func TestTransliterator_Formatter(t *testing.T) {
+	tests := []struct {
+		name string
+		mode TransliterationMode
+		s    string
+		want string
+	}{
+		{name: "keep", mode: KeepUnmappable, s: "Ærø☃", want: "Aero☃"},
+		{name: "drop", mode: DropUnmappable, s: "Ærø☃", want: "Aero"},
+		{name: "error drops", mode: ErrorOnUnmappable, s: "Ærø☃", want: "Aero"},
+	}
+
+	for _, st := range tests {
+		tt := st
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			fn := Transliterator{Tables: DefaultTransliterationTables, Mode: tt.mode}.Formatter()
+			assert.Equal(t, tt.want, fn(tt.s))
+		})
+	}
+}
+
+// TestTransliterator_Apply provides unit test coverage for Transliterator.Apply()
+func TestTransliterator_Apply(t *testing.T) {
+	fn := Transliterator{Tables: DefaultTransliterationTables, Mode: ErrorOnUnmappable}.Apply(SnakeCase)
+
+	got, err := fn("Ærø Straße")
+	assert.NoError(t, err)
+	assert.Equal(t, "aero_strasse", got)
+
+	got, err = fn("user☃name")
+	assert.Equal(t, &UnmappableError{Rune: '☃', Offset: 4}, err)
+	assert.EqualError(t, err, `no ASCII transliteration for '☃' (U+2603) at offset 4`)
+	assert.Empty(t, got)
+}
+
+// TestASCIIFold provides unit test coverage for ASCIIFold()
+func TestASCIIFold(t *testing.T) {
+	assert.Equal(t, "Aero Strasse", ASCIIFold("Ærø Straße"))
+	assert.Equal(t, " x", ASCIIFold("日本 x"))
+}
+
+// TestTransliterationMode_String provides unit test coverage for TransliterationMode.String()
+func TestTransliterationMode_String(t *testing.T) {
+	assert.Equal(t, "keep unmappable", KeepUnmappable.String())
+	assert.Equal(t, "drop unmappable", DropUnmappable.String())
+	assert.Equal(t, "error on unmappable", ErrorOnUnmappable.String())
+	assert.Equal(t, "unknown mode", TransliterationMode(9).String())
+}